@@ -7,7 +7,11 @@ require (
 	github.com/google/go-github/v71 v71.0.0
 )
 
-require github.com/google/go-querystring v1.1.0 // indirect
+require (
+	github.com/google/go-querystring v1.1.0 // indirect
+	golang.org/x/crypto v0.36.0 // indirect
+	golang.org/x/sys v0.31.0 // indirect
+)
 
 // Use version at HEAD, not the latest published.
 replace github.com/google/go-github/v71 => ../..
@@ -6,12 +6,23 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"iter"
 	"net/http"
 	"net/url"
+	"slices"
 )
 
+// ErrArtifactExpired is returned when downloading an artifact that has
+// expired and is no longer available, in which case GitHub responds with
+// 410 Gone.
+var ErrArtifactExpired = errors.New("artifact has expired")
+
 // ArtifactWorkflowRun represents a GitHub artifact's workflow run.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/artifacts
@@ -142,11 +153,92 @@ func (s *ActionsService) GetArtifact(ctx context.Context, owner, repo string, ar
 func (s *ActionsService) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64, maxRedirects int) (*url.URL, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/artifacts/%v/zip", owner, repo, artifactID)
 
+	var (
+		artifactURL *url.URL
+		resp        *Response
+		err         error
+	)
 	if s.client.RateLimitRedirectionalEndpoints {
-		return s.downloadArtifactWithRateLimit(ctx, u, maxRedirects)
+		artifactURL, resp, err = s.downloadArtifactWithRateLimit(ctx, u, maxRedirects)
+	} else {
+		artifactURL, resp, err = s.downloadArtifactWithoutRateLimit(ctx, u, maxRedirects)
+	}
+	if resp != nil && resp.StatusCode == http.StatusGone {
+		return nil, resp, ErrArtifactExpired
+	}
+
+	return artifactURL, resp, err
+}
+
+// DownloadArtifactContents downloads the zip archive of an artifact.
+// It is the caller's responsibility to close the returned ReadCloser.
+//
+// The archive is fetched from the URL returned by DownloadArtifact using
+// followRedirectsClient. That URL is pre-signed, so followRedirectsClient
+// should not add GitHub credentials to its requests. If followRedirectsClient
+// is nil, http.DefaultClient is used.
+//
+// If the artifact has expired, ErrArtifactExpired is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#download-an-artifact
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}
+func (s *ActionsService) DownloadArtifactContents(ctx context.Context, owner, repo string, artifactID int64, followRedirectsClient *http.Client) (io.ReadCloser, *Response, error) {
+	artifactURL, resp, err := s.DownloadArtifact(ctx, owner, repo, artifactID, 1)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if followRedirectsClient == nil {
+		followRedirectsClient = http.DefaultClient
+	}
+	req, err := http.NewRequest("GET", artifactURL.String(), nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	req = withContext(ctx, req)
+	archiveResp, err := followRedirectsClient.Do(req)
+	if err != nil {
+		return nil, resp, err
+	}
+	if archiveResp.StatusCode == http.StatusGone {
+		_ = archiveResp.Body.Close()
+		return nil, newResponse(archiveResp), ErrArtifactExpired
+	}
+	if err := CheckResponse(archiveResp); err != nil {
+		_ = archiveResp.Body.Close()
+		return nil, newResponse(archiveResp), err
+	}
+
+	return archiveResp.Body, resp, nil
+}
+
+// IterArtifactFiles downloads the zip archive of an artifact with
+// DownloadArtifactContents and returns an iterator over the files it
+// contains. The archive is held in memory while the files are read.
+//
+// If the artifact has expired, ErrArtifactExpired is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#download-an-artifact
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}
+func (s *ActionsService) IterArtifactFiles(ctx context.Context, owner, repo string, artifactID int64, followRedirectsClient *http.Client) (iter.Seq[*zip.File], *Response, error) {
+	rc, resp, err := s.DownloadArtifactContents(ctx, owner, repo, artifactID, followRedirectsClient)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer rc.Close()
+
+	archive, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, resp, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return s.downloadArtifactWithoutRateLimit(ctx, u, maxRedirects)
+	return slices.Values(zr.File), resp, nil
 }
 
 func (s *ActionsService) downloadArtifactWithoutRateLimit(ctx context.Context, u string, maxRedirects int) (*url.URL, *Response, error) {
@@ -6,11 +6,16 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"testing"
 
@@ -525,6 +530,183 @@ func TestActionsService_DownloadArtifact_unexpectedCode(t *testing.T) {
 	}
 }
 
+func TestActionsService_DownloadArtifact_expired(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		name              string
+		respectRateLimits bool
+	}{
+		{
+			name:              "withoutRateLimits",
+			respectRateLimits: false,
+		},
+		{
+			name:              "withRateLimits",
+			respectRateLimits: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+			client.RateLimitRedirectionalEndpoints = tc.respectRateLimits
+
+			mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				w.WriteHeader(http.StatusGone)
+				fmt.Fprint(w, `{"message":"Artifact has expired"}`)
+			})
+
+			ctx := context.Background()
+			_, resp, err := client.Actions.DownloadArtifact(ctx, "o", "r", 1, 1)
+			if !errors.Is(err, ErrArtifactExpired) {
+				t.Errorf("Actions.DownloadArtifact returned error %v, want ErrArtifactExpired", err)
+			}
+			if resp == nil || resp.StatusCode != http.StatusGone {
+				t.Errorf("Actions.DownloadArtifact returned response %+v, want status %d", resp, http.StatusGone)
+			}
+
+			_, _, err = client.Actions.DownloadArtifactContents(ctx, "o", "r", 1, nil)
+			if !errors.Is(err, ErrArtifactExpired) {
+				t.Errorf("Actions.DownloadArtifactContents returned error %v, want ErrArtifactExpired", err)
+			}
+		})
+	}
+}
+
+// testArtifactArchive returns a zip archive containing the given files.
+func testArtifactArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		f, err := zw.Create(name)
+		assertNilError(t, err)
+		_, err = f.Write([]byte(files[name]))
+		assertNilError(t, err)
+	}
+	assertNilError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestActionsService_DownloadArtifactContents(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	archive := testArtifactArchive(t, map[string]string{"a.txt": "a"})
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive", http.StatusFound)
+	})
+	mux.HandleFunc("/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header = %q, want none", got)
+		}
+		_, _ = w.Write(archive)
+	})
+
+	ctx := context.Background()
+	rc, _, err := client.Actions.DownloadArtifactContents(ctx, "o", "r", 1, http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Actions.DownloadArtifactContents returned error: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	assertNilError(t, err)
+	if !bytes.Equal(got, archive) {
+		t.Errorf("Actions.DownloadArtifactContents returned %q, want %q", got, archive)
+	}
+
+	const methodName = "DownloadArtifactContents"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.DownloadArtifactContents(ctx, "\n", "\n", -1, nil)
+		return err
+	})
+}
+
+func TestActionsService_DownloadArtifactContents_archiveError(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive", http.StatusFound)
+	})
+	mux.HandleFunc("/archive", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Actions.DownloadArtifactContents(ctx, "o", "r", 1, nil)
+	if err == nil {
+		t.Error("Actions.DownloadArtifactContents returned nil error, want 403")
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Actions.DownloadArtifactContents returned response %+v, want status %d", resp, http.StatusForbidden)
+	}
+}
+
+func TestActionsService_IterArtifactFiles(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	archive := testArtifactArchive(t, map[string]string{"a.txt": "a", "dir/b.txt": "bb"})
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive", http.StatusFound)
+	})
+	mux.HandleFunc("/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write(archive)
+	})
+
+	ctx := context.Background()
+	files, _, err := client.Actions.IterArtifactFiles(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Actions.IterArtifactFiles returned error: %v", err)
+	}
+
+	got := map[string]string{}
+	for f := range files {
+		rc, err := f.Open()
+		assertNilError(t, err)
+		b, err := io.ReadAll(rc)
+		assertNilError(t, err)
+		assertNilError(t, rc.Close())
+		got[f.Name] = string(b)
+	}
+
+	want := map[string]string{"a.txt": "a", "dir/b.txt": "bb"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Actions.IterArtifactFiles returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActionsService_IterArtifactFiles_invalidArchive(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive", http.StatusFound)
+	})
+	mux.HandleFunc("/archive", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "not a zip")
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Actions.IterArtifactFiles(ctx, "o", "r", 1, nil); err == nil {
+		t.Error("Actions.IterArtifactFiles returned nil error for an invalid archive")
+	}
+
+	const methodName = "IterArtifactFiles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.IterArtifactFiles(ctx, "\n", "\n", -1, nil)
+		return err
+	})
+}
+
 func TestActionsService_DeleteArtifact(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
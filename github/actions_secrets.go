@@ -7,9 +7,14 @@ package github
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+
+	"golang.org/x/crypto/nacl/box"
 )
 
 // PublicKey represents the public key that should be used to encrypt secrets.
@@ -270,6 +275,108 @@ func (s *ActionsService) CreateOrUpdateEnvSecret(ctx context.Context, repoID int
 	return s.putSecret(ctx, url, eSecret)
 }
 
+// sealSecret encrypts plaintext for publicKey with a libsodium sealed box,
+// as GitHub requires for secret values, and returns it base64 encoded. The
+// key must have an ID, which has to be sent along with the sealed value.
+func sealSecret(publicKey *PublicKey, plaintext string) (string, error) {
+	if publicKey.GetKeyID() == "" {
+		return "", errors.New("public key has no key ID")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(publicKey.GetKey())
+	if err != nil {
+		return "", fmt.Errorf("unable to decode public key: %w", err)
+	}
+	if len(key) != 32 {
+		return "", fmt.Errorf("public key must be 32 bytes, got %v", len(key))
+	}
+
+	var boxKey [32]byte
+	copy(boxKey[:], key)
+	sealed, err := box.SealAnonymous(nil, []byte(plaintext), &boxKey, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *ActionsService) putPlaintextSecret(ctx context.Context, publicKey *PublicKey, url string, eSecret *EncryptedSecret, plaintext string) (*Response, error) {
+	encrypted, err := sealSecret(publicKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	eSecret.KeyID = publicKey.GetKeyID()
+	eSecret.EncryptedValue = encrypted
+
+	return s.putSecret(ctx, url, eSecret)
+}
+
+// PutRepoSecret creates or updates a repository secret with a plaintext value.
+// It fetches the repository public key with GetRepoPublicKey and encrypts the
+// value with it before calling CreateOrUpdateRepoSecret.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#create-or-update-a-repository-secret
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#get-a-repository-public-key
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/secrets/public-key
+//meta:operation PUT /repos/{owner}/{repo}/actions/secrets/{secret_name}
+func (s *ActionsService) PutRepoSecret(ctx context.Context, owner, repo, name, plaintext string) (*Response, error) {
+	publicKey, resp, err := s.GetRepoPublicKey(ctx, owner, repo)
+	if err != nil {
+		return resp, err
+	}
+
+	url := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, name)
+	return s.putPlaintextSecret(ctx, publicKey, url, &EncryptedSecret{Name: name}, plaintext)
+}
+
+// PutOrgSecret creates or updates an organization secret with a plaintext
+// value. It fetches the organization public key with GetOrgPublicKey and
+// encrypts the value with it before calling CreateOrUpdateOrgSecret.
+//
+// Visibility is one of "all", "private" or "selected". selectedRepoIDs is only
+// used when visibility is "selected".
+//
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#create-or-update-an-organization-secret
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#get-an-organization-public-key
+//
+//meta:operation GET /orgs/{org}/actions/secrets/public-key
+//meta:operation PUT /orgs/{org}/actions/secrets/{secret_name}
+func (s *ActionsService) PutOrgSecret(ctx context.Context, org, name, plaintext, visibility string, selectedRepoIDs SelectedRepoIDs) (*Response, error) {
+	publicKey, resp, err := s.GetOrgPublicKey(ctx, org)
+	if err != nil {
+		return resp, err
+	}
+
+	url := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, name)
+	eSecret := &EncryptedSecret{
+		Name:                  name,
+		Visibility:            visibility,
+		SelectedRepositoryIDs: selectedRepoIDs,
+	}
+	return s.putPlaintextSecret(ctx, publicKey, url, eSecret, plaintext)
+}
+
+// PutEnvSecret creates or updates an environment secret with a plaintext
+// value. It fetches the environment public key with GetEnvPublicKey and
+// encrypts the value with it before calling CreateOrUpdateEnvSecret.
+//
+// GitHub API docs: https://docs.github.com/enterprise-server@3.7/rest/actions/secrets#create-or-update-an-environment-secret
+// GitHub API docs: https://docs.github.com/enterprise-server@3.7/rest/actions/secrets#get-an-environment-public-key
+//
+//meta:operation GET /repositories/{repository_id}/environments/{environment_name}/secrets/public-key
+//meta:operation PUT /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
+func (s *ActionsService) PutEnvSecret(ctx context.Context, repoID int, env, name, plaintext string) (*Response, error) {
+	publicKey, resp, err := s.GetEnvPublicKey(ctx, repoID, env)
+	if err != nil {
+		return resp, err
+	}
+
+	url := fmt.Sprintf("repositories/%v/environments/%v/secrets/%v", repoID, env, name)
+	return s.putPlaintextSecret(ctx, publicKey, url, &EncryptedSecret{Name: name}, plaintext)
+}
+
 func (s *ActionsService) deleteSecret(ctx context.Context, url string) (*Response, error) {
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
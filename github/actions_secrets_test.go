@@ -6,14 +6,18 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/nacl/box"
 )
 
 func TestPublicKey_UnmarshalJSON(t *testing.T) {
@@ -831,6 +835,175 @@ func TestActionsService_CreateOrUpdateEnvSecret(t *testing.T) {
 	})
 }
 
+// testSecretKeyPair returns a fixed key pair for testing secret encryption.
+func testSecretKeyPair(t *testing.T) (publicKey, privateKey *[32]byte) {
+	t.Helper()
+	seed := bytes.Repeat([]byte{7}, 32)
+	publicKey, privateKey, err := box.GenerateKey(bytes.NewReader(seed))
+	if err != nil {
+		t.Fatalf("box.GenerateKey returned error: %v", err)
+	}
+	return publicKey, privateKey
+}
+
+// testOpenSecret decodes the encrypted_value of an EncryptedSecret request
+// body and opens it with the given key pair.
+func testOpenSecret(t *testing.T, r *http.Request, publicKey, privateKey *[32]byte) (keyID, plaintext string) {
+	t.Helper()
+	var v struct {
+		KeyID          string `json:"key_id"`
+		EncryptedValue string `json:"encrypted_value"`
+	}
+	assertNilError(t, json.NewDecoder(r.Body).Decode(&v))
+
+	sealed, err := base64.StdEncoding.DecodeString(v.EncryptedValue)
+	if err != nil {
+		t.Fatalf("encrypted_value %q is not base64: %v", v.EncryptedValue, err)
+	}
+	opened, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+	if !ok {
+		t.Fatalf("encrypted_value %q could not be opened", v.EncryptedValue)
+	}
+	return v.KeyID, string(opened)
+}
+
+func TestActionsService_PutRepoSecret(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	publicKey, privateKey := testSecretKeyPair(t)
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/repos/o/r/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		keyID, plaintext := testOpenSecret(t, r, publicKey, privateKey)
+		if keyID != "1234" {
+			t.Errorf("key_id = %q, want %q", keyID, "1234")
+		}
+		if plaintext != "s3cr3t" {
+			t.Errorf("decrypted value = %q, want %q", plaintext, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.PutRepoSecret(ctx, "o", "r", "NAME", "s3cr3t")
+	if err != nil {
+		t.Errorf("Actions.PutRepoSecret returned error: %v", err)
+	}
+
+	const methodName = "PutRepoSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.PutRepoSecret(ctx, "\n", "\n", "\n", "s3cr3t")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.PutRepoSecret(ctx, "o", "r", "NAME", "s3cr3t")
+	})
+}
+
+func TestActionsService_PutRepoSecret_invalidPublicKey(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"c2hvcnQ="}`)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Actions.PutRepoSecret(ctx, "o", "r", "NAME", "s3cr3t"); err == nil {
+		t.Error("Actions.PutRepoSecret returned nil error for a short public key")
+	}
+}
+
+func TestActionsService_PutOrgSecret(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	publicKey, privateKey := testSecretKeyPair(t)
+
+	mux.HandleFunc("/orgs/o/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":1234,"key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/orgs/o/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, err := io.ReadAll(r.Body)
+		assertNilError(t, err)
+		var v EncryptedSecret
+		assertNilError(t, json.Unmarshal(body, &v))
+		if v.Visibility != "selected" || !cmp.Equal(v.SelectedRepositoryIDs, SelectedRepoIDs{1296269, 1269280}) {
+			t.Errorf("Request body = %s, want selected visibility with repository IDs", body)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		keyID, plaintext := testOpenSecret(t, r, publicKey, privateKey)
+		if keyID != "1234" {
+			t.Errorf("key_id = %q, want %q", keyID, "1234")
+		}
+		if plaintext != "s3cr3t" {
+			t.Errorf("decrypted value = %q, want %q", plaintext, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.PutOrgSecret(ctx, "o", "NAME", "s3cr3t", "selected", SelectedRepoIDs{1296269, 1269280})
+	if err != nil {
+		t.Errorf("Actions.PutOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "PutOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.PutOrgSecret(ctx, "\n", "\n", "s3cr3t", "all", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.PutOrgSecret(ctx, "o", "NAME", "s3cr3t", "selected", SelectedRepoIDs{1296269, 1269280})
+	})
+}
+
+func TestActionsService_PutEnvSecret(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	publicKey, privateKey := testSecretKeyPair(t)
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/repositories/1/environments/e/secrets/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		keyID, plaintext := testOpenSecret(t, r, publicKey, privateKey)
+		if keyID != "1234" {
+			t.Errorf("key_id = %q, want %q", keyID, "1234")
+		}
+		if plaintext != "s3cr3t" {
+			t.Errorf("decrypted value = %q, want %q", plaintext, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Actions.PutEnvSecret(ctx, 1, "e", "secret", "s3cr3t")
+	if err != nil {
+		t.Errorf("Actions.PutEnvSecret returned error: %v", err)
+	}
+
+	const methodName = "PutEnvSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.PutEnvSecret(ctx, 0, "\n", "\n", "s3cr3t")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.PutEnvSecret(ctx, 1, "e", "secret", "s3cr3t")
+	})
+}
+
 func TestActionsService_DeleteEnvSecret(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
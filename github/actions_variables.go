@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // ActionsVariable represents a repository action variable.
@@ -59,6 +60,29 @@ func (s *ActionsService) ListRepoVariables(ctx context.Context, owner, repo stri
 	return s.listVariables(ctx, url, opts)
 }
 
+// ListAllRepoVariables lists all variables available in a repository,
+// following pagination until every page has been fetched. The returned
+// Response is the one for the last page.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-repository-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/variables
+func (s *ActionsService) ListAllRepoVariables(ctx context.Context, owner, repo string) ([]*ActionsVariable, *Response, error) {
+	opts := &ListOptions{PerPage: 30}
+	var all []*ActionsVariable
+	for {
+		variables, resp, err := s.ListRepoVariables(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, variables.Variables...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // ListRepoOrgVariables lists all organization variables available in a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/variables#list-repository-organization-variables
@@ -210,6 +234,30 @@ func (s *ActionsService) UpdateEnvVariable(ctx context.Context, owner, repo, env
 	return s.patchVariable(ctx, url, variable)
 }
 
+// UpsertRepoVariable sets the value of a repository variable, updating it if
+// it exists and creating it otherwise. It reports whether the variable was
+// created.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#create-a-repository-variable
+// GitHub API docs: https://docs.github.com/rest/actions/variables#update-a-repository-variable
+//
+//meta:operation POST /repos/{owner}/{repo}/actions/variables
+//meta:operation PATCH /repos/{owner}/{repo}/actions/variables/{name}
+func (s *ActionsService) UpsertRepoVariable(ctx context.Context, owner, repo, name, value string) (bool, *Response, error) {
+	variable := &ActionsVariable{Name: name, Value: value}
+	resp, err := s.UpdateRepoVariable(ctx, owner, repo, variable)
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return false, resp, err
+	}
+
+	resp, err = s.CreateRepoVariable(ctx, owner, repo, variable)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return true, resp, nil
+}
+
 func (s *ActionsService) deleteVariable(ctx context.Context, url string) (*Response, error) {
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
@@ -58,6 +58,54 @@ func TestActionsService_ListRepoVariables(t *testing.T) {
 	})
 }
 
+func TestActionsService_ListAllRepoVariables(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "30"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/actions/variables?per_page=30&page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":2,"variables":[{"name":"A","value":"AA"}]}`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "30", "page": "2"})
+			fmt.Fprint(w, `{"total_count":2,"variables":[{"name":"B","value":"BB"}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	variables, _, err := client.Actions.ListAllRepoVariables(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.ListAllRepoVariables returned error: %v", err)
+	}
+
+	want := []*ActionsVariable{
+		{Name: "A", Value: "AA"},
+		{Name: "B", Value: "BB"},
+	}
+	if !cmp.Equal(variables, want) {
+		t.Errorf("Actions.ListAllRepoVariables returned %+v, want %+v", variables, want)
+	}
+
+	const methodName = "ListAllRepoVariables"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.ListAllRepoVariables(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.ListAllRepoVariables(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestActionsService_ListRepoOrgVariables(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -205,6 +253,89 @@ func TestActionsService_UpdateRepoVariable(t *testing.T) {
 	})
 }
 
+func TestActionsService_UpsertRepoVariable_update(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/variables/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"NAME","value":"VALUE"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/actions/variables", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("UpsertRepoVariable created an existing variable")
+	})
+
+	ctx := context.Background()
+	created, _, err := client.Actions.UpsertRepoVariable(ctx, "o", "r", "NAME", "VALUE")
+	if err != nil {
+		t.Errorf("Actions.UpsertRepoVariable returned error: %v", err)
+	}
+	if created {
+		t.Error("Actions.UpsertRepoVariable returned created = true, want false")
+	}
+
+	const methodName = "UpsertRepoVariable"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.UpsertRepoVariable(ctx, "\n", "\n", "\n", "VALUE")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.UpsertRepoVariable(ctx, "o", "r", "NAME", "VALUE")
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_UpsertRepoVariable_create(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/variables/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"NAME","value":"VALUE"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	created, _, err := client.Actions.UpsertRepoVariable(ctx, "o", "r", "NAME", "VALUE")
+	if err != nil {
+		t.Errorf("Actions.UpsertRepoVariable returned error: %v", err)
+	}
+	if !created {
+		t.Error("Actions.UpsertRepoVariable returned created = false, want true")
+	}
+}
+
+func TestActionsService_UpsertRepoVariable_createError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/variables/NAME", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/actions/variables", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	created, _, err := client.Actions.UpsertRepoVariable(ctx, "o", "r", "NAME", "VALUE")
+	if err == nil {
+		t.Error("Actions.UpsertRepoVariable returned nil error, want 403")
+	}
+	if created {
+		t.Error("Actions.UpsertRepoVariable returned created = true, want false")
+	}
+}
+
 func TestActionsService_DeleteRepoVariable(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
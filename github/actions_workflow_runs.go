@@ -304,6 +304,13 @@ func (s *ActionsService) getWorkflowRunAttemptLogsWithRateLimit(ctx context.Cont
 	return url, resp, nil
 }
 
+// RerunOptions specifies optional parameters to the RerunFailedJobsByIDWithOptions
+// and RerunJobByIDWithOptions methods.
+type RerunOptions struct {
+	// EnableDebugLogging enables debug logging for the re-run.
+	EnableDebugLogging bool `json:"enable_debug_logging,omitempty"`
+}
+
 // RerunWorkflowByID re-runs a workflow by ID.
 // You can use the helper function *DeploymentProtectionRuleEvent.GetRunID() to easily retrieve the workflow run ID a the DeploymentProtectionRuleEvent.
 //
@@ -328,9 +335,19 @@ func (s *ActionsService) RerunWorkflowByID(ctx context.Context, owner, repo stri
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs
 func (s *ActionsService) RerunFailedJobsByID(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
+	return s.RerunFailedJobsByIDWithOptions(ctx, owner, repo, runID, nil)
+}
+
+// RerunFailedJobsByIDWithOptions is like RerunFailedJobsByID, but allows
+// setting options such as enabling debug logging for the re-run.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#re-run-failed-jobs-from-a-workflow-run
+//
+//meta:operation POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs
+func (s *ActionsService) RerunFailedJobsByIDWithOptions(ctx context.Context, owner, repo string, runID int64, opts *RerunOptions) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun-failed-jobs", owner, repo, runID)
 
-	req, err := s.client.NewRequest("POST", u, nil)
+	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -346,9 +363,19 @@ func (s *ActionsService) RerunFailedJobsByID(ctx context.Context, owner, repo st
 //
 //meta:operation POST /repos/{owner}/{repo}/actions/jobs/{job_id}/rerun
 func (s *ActionsService) RerunJobByID(ctx context.Context, owner, repo string, jobID int64) (*Response, error) {
+	return s.RerunJobByIDWithOptions(ctx, owner, repo, jobID, nil)
+}
+
+// RerunJobByIDWithOptions is like RerunJobByID, but allows setting options
+// such as enabling debug logging for the re-run.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#re-run-a-job-from-a-workflow-run
+//
+//meta:operation POST /repos/{owner}/{repo}/actions/jobs/{job_id}/rerun
+func (s *ActionsService) RerunJobByIDWithOptions(ctx context.Context, owner, repo string, jobID int64, opts *RerunOptions) (*Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/actions/jobs/%v/rerun", owner, repo, jobID)
 
-	req, err := s.client.NewRequest("POST", u, nil)
+	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -471,6 +471,68 @@ func TestActionsService_RerunJobByID(t *testing.T) {
 	})
 }
 
+func TestActionsService_RerunFailedJobsByIDWithOptions(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/runs/3434/rerun-failed-jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"enable_debug_logging":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	opts := &RerunOptions{EnableDebugLogging: true}
+	resp, err := client.Actions.RerunFailedJobsByIDWithOptions(ctx, "o", "r", 3434, opts)
+	if err != nil {
+		t.Errorf("Actions.RerunFailedJobsByIDWithOptions returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Actions.RerunFailedJobsByIDWithOptions returned status: %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	const methodName = "RerunFailedJobsByIDWithOptions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.RerunFailedJobsByIDWithOptions(ctx, "\n", "\n", 3434, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.RerunFailedJobsByIDWithOptions(ctx, "o", "r", 3434, opts)
+	})
+}
+
+func TestActionsService_RerunJobByIDWithOptions(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/jobs/3434/rerun", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"enable_debug_logging":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	opts := &RerunOptions{EnableDebugLogging: true}
+	resp, err := client.Actions.RerunJobByIDWithOptions(ctx, "o", "r", 3434, opts)
+	if err != nil {
+		t.Errorf("Actions.RerunJobByIDWithOptions returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Actions.RerunJobByIDWithOptions returned status: %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	const methodName = "RerunJobByIDWithOptions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Actions.RerunJobByIDWithOptions(ctx, "\n", "\n", 3434, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Actions.RerunJobByIDWithOptions(ctx, "o", "r", 3434, opts)
+	})
+}
+
 func TestActionsService_CancelWorkflowRunByID(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
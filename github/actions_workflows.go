@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"sync"
 )
 
 // Workflow represents a repository action workflow.
@@ -156,6 +157,102 @@ func (s *ActionsService) getWorkflowUsage(ctx context.Context, url string) (*Wor
 	return workflowUsage, resp, nil
 }
 
+// maxConcurrentUsageRequests bounds the number of workflow usage requests
+// GetRepoActionsUsage keeps in flight at once.
+const maxConcurrentUsageRequests = 4
+
+// GetRepoActionsUsage gets the billable usage of all workflows in a repository,
+// summed per runner environment, in the unit of billable milliseconds.
+//
+// It lists every workflow in the repository and fetches their usage concurrently.
+// The first error encountered cancels the remaining requests and is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflows#get-workflow-usage
+// GitHub API docs: https://docs.github.com/rest/actions/workflows#list-repository-workflows
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing
+func (s *ActionsService) GetRepoActionsUsage(ctx context.Context, owner, repo string) (*WorkflowUsage, *Response, error) {
+	var workflowIDs []int64
+	var resp *Response
+	opts := &ListOptions{PerPage: 100}
+	for {
+		workflows, r, err := s.ListWorkflows(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, r, err
+		}
+		resp = r
+		for _, w := range workflows.Workflows {
+			workflowIDs = append(workflowIDs, w.GetID())
+		}
+		if r.NextPage == 0 {
+			break
+		}
+		opts.Page = r.NextPage
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		errResp  *Response
+	)
+	usages := make([]*WorkflowUsage, len(workflowIDs))
+	sem := make(chan struct{}, maxConcurrentUsageRequests)
+	for i, id := range workflowIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			usage, r, err := s.GetWorkflowUsageByID(ctx, owner, repo, id)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr, errResp = err, r
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			usages[i] = usage
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, errResp, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, resp, err
+	}
+
+	total := WorkflowBillMap{}
+	for _, usage := range usages {
+		if usage.Billable == nil {
+			continue
+		}
+		for env, bill := range *usage.Billable {
+			sum, ok := total[env]
+			if !ok {
+				sum = &WorkflowBill{TotalMS: Ptr(int64(0))}
+				total[env] = sum
+			}
+			*sum.TotalMS += bill.GetTotalMS()
+		}
+	}
+
+	return &WorkflowUsage{Billable: &total}, resp, nil
+}
+
 // CreateWorkflowDispatchEventByID manually triggers a GitHub Actions workflow run.
 //
 // GitHub API docs: https://docs.github.com/rest/actions/workflows#create-a-workflow-dispatch-event
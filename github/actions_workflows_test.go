@@ -184,6 +184,90 @@ func TestActionsService_GetWorkflowUsageByID(t *testing.T) {
 	})
 }
 
+func TestActionsService_GetRepoActionsUsage(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `{"total_count":2,"workflows":[{"id":1},{"id":2}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/1/timing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"billable":{"UBUNTU":{"total_ms":180000},"MACOS":{"total_ms":240000}}}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/2/timing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"billable":{"UBUNTU":{"total_ms":60000},"WINDOWS":{"total_ms":300000}}}`)
+	})
+
+	ctx := context.Background()
+	usage, _, err := client.Actions.GetRepoActionsUsage(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetRepoActionsUsage returned error: %v", err)
+	}
+
+	want := &WorkflowUsage{
+		Billable: &WorkflowBillMap{
+			"UBUNTU": &WorkflowBill{
+				TotalMS: Ptr(int64(240000)),
+			},
+			"MACOS": &WorkflowBill{
+				TotalMS: Ptr(int64(240000)),
+			},
+			"WINDOWS": &WorkflowBill{
+				TotalMS: Ptr(int64(300000)),
+			},
+		},
+	}
+	if !cmp.Equal(usage, want) {
+		t.Errorf("Actions.GetRepoActionsUsage returned %+v, want %+v", usage, want)
+	}
+
+	const methodName = "GetRepoActionsUsage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.GetRepoActionsUsage(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Actions.GetRepoActionsUsage(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActionsService_GetRepoActionsUsage_usageError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":2,"workflows":[{"id":1},{"id":2}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/1/timing", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"billable":{"UBUNTU":{"total_ms":180000}}}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/2/timing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	usage, resp, err := client.Actions.GetRepoActionsUsage(ctx, "o", "r")
+	if err == nil {
+		t.Error("Actions.GetRepoActionsUsage returned nil error, want error")
+	}
+	if usage != nil {
+		t.Errorf("Actions.GetRepoActionsUsage returned %+v, want nil", usage)
+	}
+	if resp == nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Actions.GetRepoActionsUsage returned response %+v, want status %v", resp, http.StatusInternalServerError)
+	}
+}
+
 func TestActionsService_GetWorkflowUsageByFileName(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
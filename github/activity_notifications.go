@@ -73,6 +73,34 @@ func (s *ActivityService) ListNotifications(ctx context.Context, opts *Notificat
 	return notifications, resp, nil
 }
 
+// ListAllNotifications lists all notifications for the authenticated user,
+// following pagination until every page has been fetched. The filters in opts
+// are applied to every page and opts is not modified. The returned Response is
+// the one for the last page.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#list-notifications-for-the-authenticated-user
+//
+//meta:operation GET /notifications
+func (s *ActivityService) ListAllNotifications(ctx context.Context, opts *NotificationListOptions) ([]*Notification, *Response, error) {
+	var o NotificationListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var all []*Notification
+	for {
+		notifications, resp, err := s.ListNotifications(ctx, &o)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, notifications...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		o.Page = resp.NextPage
+	}
+}
+
 // ListRepositoryNotifications lists all notifications in a given repository
 // for the authenticated user.
 //
@@ -256,3 +284,36 @@ func (s *ActivityService) DeleteThreadSubscription(ctx context.Context, id strin
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// IgnoreThread mutes all future notifications for the specified thread for
+// the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#set-a-thread-subscription
+//
+//meta:operation PUT /notifications/threads/{thread_id}/subscription
+func (s *ActivityService) IgnoreThread(ctx context.Context, id string) (*Subscription, *Response, error) {
+	return s.SetThreadSubscription(ctx, id, &Subscription{Ignored: Ptr(true)})
+}
+
+// Subscribe subscribes the authenticated user to the specified thread, so
+// that they receive notifications for it even if they were not previously
+// participating or had ignored it.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#set-a-thread-subscription
+//
+//meta:operation PUT /notifications/threads/{thread_id}/subscription
+func (s *ActivityService) Subscribe(ctx context.Context, id string) (*Subscription, *Response, error) {
+	return s.SetThreadSubscription(ctx, id, &Subscription{Ignored: Ptr(false)})
+}
+
+// Unsubscribe removes the authenticated user's subscription to the specified
+// thread. They stop receiving notifications for it until they comment on the
+// thread or are @mentioned. GitHub does not return a subscription for this
+// operation.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#delete-a-thread-subscription
+//
+//meta:operation DELETE /notifications/threads/{thread_id}/subscription
+func (s *ActivityService) Unsubscribe(ctx context.Context, id string) (*Response, error) {
+	return s.DeleteThreadSubscription(ctx, id)
+}
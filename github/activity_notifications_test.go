@@ -59,6 +59,59 @@ func TestActivityService_ListNotification(t *testing.T) {
 	})
 }
 
+func TestActivityService_ListAllNotifications(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := values{
+			"all":           "true",
+			"participating": "true",
+			"since":         "2006-01-02T15:04:05Z",
+			"before":        "2007-03-04T15:04:05Z",
+		}
+		if r.FormValue("page") == "2" {
+			want["page"] = "2"
+			testFormValues(t, r, want)
+			fmt.Fprint(w, `[{"id":"2"}]`)
+			return
+		}
+		testFormValues(t, r, want)
+		w.Header().Set("Link", `<https://api.github.com/notifications?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	opt := &NotificationListOptions{
+		All:           true,
+		Participating: true,
+		Since:         time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC),
+		Before:        time.Date(2007, time.March, 04, 15, 04, 05, 0, time.UTC),
+	}
+	ctx := context.Background()
+	notifications, _, err := client.Activity.ListAllNotifications(ctx, opt)
+	if err != nil {
+		t.Errorf("Activity.ListAllNotifications returned error: %v", err)
+	}
+
+	want := []*Notification{{ID: Ptr("1")}, {ID: Ptr("2")}}
+	if !cmp.Equal(notifications, want) {
+		t.Errorf("Activity.ListAllNotifications returned %+v, want %+v", notifications, want)
+	}
+	if opt.Page != 0 {
+		t.Errorf("Activity.ListAllNotifications modified opts.Page = %v, want 0", opt.Page)
+	}
+
+	const methodName = "ListAllNotifications"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.ListAllNotifications(ctx, opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestActivityService_ListRepositoryNotifications(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -339,6 +392,105 @@ func TestActivityService_DeleteThreadSubscription(t *testing.T) {
 	})
 }
 
+func TestActivityService_IgnoreThread(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"ignored":true}`+"\n")
+		fmt.Fprint(w, `{"subscribed":false,"ignored":true}`)
+	})
+
+	ctx := context.Background()
+	sub, _, err := client.Activity.IgnoreThread(ctx, "1")
+	if err != nil {
+		t.Errorf("Activity.IgnoreThread returned error: %v", err)
+	}
+
+	want := &Subscription{Subscribed: Ptr(false), Ignored: Ptr(true)}
+	if !cmp.Equal(sub, want) {
+		t.Errorf("Activity.IgnoreThread returned %+v, want %+v", sub, want)
+	}
+
+	const methodName = "IgnoreThread"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Activity.IgnoreThread(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.IgnoreThread(ctx, "1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActivityService_Subscribe(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"ignored":false}`+"\n")
+		fmt.Fprint(w, `{"subscribed":true,"ignored":false}`)
+	})
+
+	ctx := context.Background()
+	sub, _, err := client.Activity.Subscribe(ctx, "1")
+	if err != nil {
+		t.Errorf("Activity.Subscribe returned error: %v", err)
+	}
+
+	want := &Subscription{Subscribed: Ptr(true), Ignored: Ptr(false)}
+	if !cmp.Equal(sub, want) {
+		t.Errorf("Activity.Subscribe returned %+v, want %+v", sub, want)
+	}
+
+	const methodName = "Subscribe"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Activity.Subscribe(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.Subscribe(ctx, "1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActivityService_Unsubscribe(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, "")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Activity.Unsubscribe(ctx, "1")
+	if err != nil {
+		t.Errorf("Activity.Unsubscribe returned error: %v", err)
+	}
+
+	const methodName = "Unsubscribe"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Activity.Unsubscribe(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Activity.Unsubscribe(ctx, "1")
+	})
+}
+
 func TestNotification_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &Notification{}, "{}")
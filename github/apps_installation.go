@@ -86,6 +86,52 @@ func (s *AppsService) ListUserRepos(ctx context.Context, id int64, opts *ListOpt
 	return r, resp, nil
 }
 
+// ListAllInstallationRepos lists all the repositories that are accessible
+// to the authenticated installation, following pagination.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-repositories-accessible-to-the-app-installation
+//
+//meta:operation GET /installation/repositories
+func (s *AppsService) ListAllInstallationRepos(ctx context.Context) ([]*Repository, *Response, error) {
+	return listAllInstallationRepos(func(opts *ListOptions) (*ListRepositories, *Response, error) {
+		return s.ListRepos(ctx, opts)
+	})
+}
+
+// ListAllUserInstallationRepos lists all the repositories that are
+// accessible to the authenticated user for an installation, following
+// pagination.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-repositories-accessible-to-the-user-access-token
+//
+//meta:operation GET /user/installations/{installation_id}/repositories
+func (s *AppsService) ListAllUserInstallationRepos(ctx context.Context, installationID int64) ([]*Repository, *Response, error) {
+	return listAllInstallationRepos(func(opts *ListOptions) (*ListRepositories, *Response, error) {
+		return s.ListUserRepos(ctx, installationID, opts)
+	})
+}
+
+// listAllInstallationRepos calls list for every page and collects the
+// repositories from the returned envelopes.
+func listAllInstallationRepos(list func(opts *ListOptions) (*ListRepositories, *Response, error)) ([]*Repository, *Response, error) {
+	var all []*Repository
+	opts := &ListOptions{PerPage: 100}
+	for {
+		r, resp, err := list(opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		if r != nil {
+			all = append(all, r.Repositories...)
+		}
+
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // AddRepository adds a single repository to an installation.
 //
 // GitHub API docs: https://docs.github.com/rest/apps/installations#add-a-repository-to-an-app-installation
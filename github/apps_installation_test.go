@@ -102,6 +102,81 @@ func TestAppsService_ListUserRepos(t *testing.T) {
 	})
 }
 
+func TestAppsService_ListAllInstallationRepos(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/installation/repositories?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `{"total_count":3,"repositories":[{"id":1},{"id":2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":3,"repositories":[{"id":3}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	repos, _, err := client.Apps.ListAllInstallationRepos(ctx)
+	if err != nil {
+		t.Errorf("Apps.ListAllInstallationRepos returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}, {ID: Ptr(int64(3))}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Apps.ListAllInstallationRepos returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListAllInstallationRepos"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Apps.ListAllInstallationRepos(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAppsService_ListAllUserInstallationRepos(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/user/installations/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/user/installations/1/repositories?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `{"total_count":2,"repositories":[{"id":1}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":2,"repositories":[{"id":2}]}`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	repos, _, err := client.Apps.ListAllUserInstallationRepos(ctx, 1)
+	if err != nil {
+		t.Errorf("Apps.ListAllUserInstallationRepos returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Apps.ListAllUserInstallationRepos returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListAllUserInstallationRepos"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Apps.ListAllUserInstallationRepos(ctx, -1)
+		return err
+	})
+}
+
 func TestAppsService_AddRepository(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
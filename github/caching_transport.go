@@ -0,0 +1,226 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httputil"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+	headerVary            = "Vary"
+
+	// HeaderFromCache is set to "1" on responses served by CachingTransport
+	// from its cache after the server answered 304 Not Modified.
+	HeaderFromCache = "X-From-Cache"
+)
+
+// ResponseCache is the storage backend used by CachingTransport. Keys are
+// opaque strings and values are serialized HTTP responses. Implementations
+// must be safe for concurrent use.
+type ResponseCache interface {
+	// Get returns the value stored for key and whether it was found.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key, replacing any previous value.
+	Set(key string, value []byte)
+}
+
+// MemoryCache is an in-memory ResponseCache. The zero value is ready to use.
+type MemoryCache struct {
+	mu    sync.RWMutex
+	items map[string][]byte
+}
+
+// Get implements the ResponseCache interface.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, ok := c.items[key]
+	return value, ok
+}
+
+// Set implements the ResponseCache interface.
+func (c *MemoryCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.items == nil {
+		c.items = make(map[string][]byte)
+	}
+	c.items[key] = value
+}
+
+/*
+CachingTransport is an http.RoundTripper that caches successful GET responses
+carrying an ETag or Last-Modified header, and revalidates them with
+If-None-Match and If-Modified-Since on subsequent requests. When GitHub answers
+304 Not Modified, the cached response is returned instead. Conditional
+requests answered with 304 do not count against the primary rate limit.
+
+	t := &github.CachingTransport{Cache: new(github.MemoryCache)}
+	client := github.NewClient(t.Client())
+
+Responses are cached per URL and per value of the request headers named in the
+response's Vary header, so responses for different credentials are kept apart.
+Cache keys are hashed and do not contain header values.
+
+See https://docs.github.com/rest/using-the-rest-api/best-practices-for-using-the-rest-api#use-conditional-requests-if-appropriate
+for more information.
+*/
+type CachingTransport struct {
+	// Cache stores the cached responses. If nil, requests are passed
+	// through to Transport without caching.
+	Cache ResponseCache
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *CachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Cache == nil || req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.transport().RoundTrip(req)
+	}
+
+	url := req.URL.String()
+	var vary []string
+	if b, ok := t.Cache.Get(varyIndexKey(url)); ok && len(b) > 0 {
+		vary = strings.Split(string(b), ",")
+	}
+
+	cached := t.cachedResponse(req, responseCacheKey(req, vary))
+	outReq := req
+	if cached != nil {
+		outReq = req.Clone(req.Context())
+		if etag := cached.Header.Get(headerETag); etag != "" {
+			outReq.Header.Set(headerIfNoneMatch, etag)
+		}
+		if lastModified := cached.Header.Get(headerLastModified); lastModified != "" {
+			outReq.Header.Set(headerIfModifiedSince, lastModified)
+		}
+	}
+
+	resp, err := t.transport().RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		// Keep the fresh headers, such as the rate limit ones, from the 304.
+		for k, v := range resp.Header {
+			cached.Header[k] = v
+		}
+		cached.Header.Set(HeaderFromCache, "1")
+		return cached, nil
+	}
+
+	if resp.StatusCode == http.StatusOK && (resp.Header.Get(headerETag) != "" || resp.Header.Get(headerLastModified) != "") {
+		t.store(req, resp)
+	}
+
+	return resp, nil
+}
+
+// Client returns an *http.Client that caches responses using t.
+func (t *CachingTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *CachingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// cachedResponse returns the response stored under key, or nil if there is
+// none or it cannot be decoded.
+func (t *CachingTransport) cachedResponse(req *http.Request, key string) *http.Response {
+	b, ok := t.Cache.Get(key)
+	if !ok {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+	if err != nil {
+		return nil
+	}
+	return resp
+}
+
+// store saves resp in the cache. Responses that vary on every header are not
+// cached. resp.Body is replaced with an equivalent unread body.
+func (t *CachingTransport) store(req *http.Request, resp *http.Response) {
+	vary := varyHeaders(resp.Header)
+	if slices.Contains(vary, "*") {
+		return
+	}
+
+	b, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return
+	}
+
+	t.Cache.Set(varyIndexKey(req.URL.String()), []byte(strings.Join(vary, ",")))
+	t.Cache.Set(responseCacheKey(req, vary), b)
+}
+
+// varyHeaders returns the sorted, canonical header names listed in the Vary
+// header of h.
+func varyHeaders(h http.Header) []string {
+	var names []string
+	for _, v := range h.Values(headerVary) {
+		for _, name := range strings.Split(v, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			if name != "*" {
+				name = http.CanonicalHeaderKey(name)
+			}
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// varyIndexKey returns the cache key under which the Vary header names of the
+// last response cached for url are stored.
+func varyIndexKey(url string) string {
+	return hashCacheKey("vary\n" + url)
+}
+
+// responseCacheKey returns the cache key for req, taking into account the
+// values of the request headers named in vary.
+func responseCacheKey(req *http.Request, vary []string) string {
+	var sb strings.Builder
+	sb.WriteString("response\n")
+	sb.WriteString(req.URL.String())
+	for _, name := range vary {
+		sb.WriteString("\n")
+		sb.WriteString(name)
+		sb.WriteString(":")
+		sb.WriteString(strings.Join(req.Header.Values(name), ","))
+	}
+	return hashCacheKey(sb.String())
+}
+
+func hashCacheKey(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
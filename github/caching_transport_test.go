@@ -0,0 +1,129 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCachingTransport_notModified(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.client.Transport = &CachingTransport{Cache: new(MemoryCache), Transport: client.client.Transport}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		switch calls {
+		case 1:
+			testHeader(t, r, "If-None-Match", "")
+			w.Header().Set("ETag", `"abc"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Header().Set(headerRateRemaining, "59")
+			fmt.Fprint(w, `{"id":1,"name":"r"}`)
+		case 2:
+			testHeader(t, r, "If-None-Match", `"abc"`)
+			testHeader(t, r, "If-Modified-Since", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Header().Set(headerRateRemaining, "59")
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			t.Errorf("unexpected request #%v", calls)
+		}
+	})
+
+	ctx := context.Background()
+	want := &Repository{ID: Ptr(int64(1)), Name: Ptr("r")}
+	for i := 1; i <= 2; i++ {
+		repo, resp, err := client.Repositories.Get(ctx, "o", "r")
+		if err != nil {
+			t.Fatalf("Repositories.Get #%v returned error: %v", i, err)
+		}
+		if !cmp.Equal(repo, want) {
+			t.Errorf("Repositories.Get #%v returned %+v, want %+v", i, repo, want)
+		}
+		if got, want := resp.Rate.Remaining, 59; got != want {
+			t.Errorf("Repositories.Get #%v Rate.Remaining = %v, want %v", i, got, want)
+		}
+		wantFromCache := ""
+		if i == 2 {
+			wantFromCache = "1"
+		}
+		if got := resp.Header.Get(HeaderFromCache); got != wantFromCache {
+			t.Errorf("Repositories.Get #%v %v header = %q, want %q", i, HeaderFromCache, got, wantFromCache)
+		}
+	}
+}
+
+func TestCachingTransport_vary(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	transport := &CachingTransport{Cache: new(MemoryCache), Transport: client.client.Transport}
+	client.client.Transport = transport
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("unexpected conditional request for Authorization %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("ETag", `"`+r.Header.Get("Authorization")+`"`)
+		w.Header().Set("Vary", "Accept, Authorization")
+		fmt.Fprintf(w, `{"login":%q}`, r.Header.Get("Authorization"))
+	})
+
+	ctx := context.Background()
+	for _, token := range []string{"a", "b"} {
+		c := client.WithAuthToken(token)
+		user, _, err := c.Users.Get(ctx, "")
+		if err != nil {
+			t.Fatalf("Users.Get returned error: %v", err)
+		}
+		if got, want := user.GetLogin(), "Bearer "+token; got != want {
+			t.Errorf("Users.Get returned login %q, want %q", got, want)
+		}
+	}
+}
+
+func TestCachingTransport_nonGET(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	cache := new(MemoryCache)
+	client.client.Transport = &CachingTransport{Cache: cache, Transport: client.client.Transport}
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.Header().Set("ETag", `"abc"`)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.Edit(ctx, "o", "r", &Repository{}); err != nil {
+		t.Fatalf("Repositories.Edit returned error: %v", err)
+	}
+	if len(cache.items) != 0 {
+		t.Errorf("CachingTransport cached %v items for a PATCH request, want 0", len(cache.items))
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	t.Parallel()
+	var c MemoryCache
+
+	if _, ok := c.Get("k"); ok {
+		t.Error("MemoryCache.Get on empty cache returned ok = true")
+	}
+
+	c.Set("k", []byte("v"))
+	got, ok := c.Get("k")
+	if !ok || string(got) != "v" {
+		t.Errorf("MemoryCache.Get = %q, %v, want %q, true", got, ok, "v")
+	}
+}
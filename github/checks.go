@@ -7,9 +7,14 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// ErrCheckSuiteNotFound is returned by ChecksService.GetCheckSuiteForApp when
+// the app has no check suite for the ref.
+var ErrCheckSuiteNotFound = errors.New("check suite not found")
+
 // ChecksService provides access to the Checks API in the
 // GitHub API.
 //
@@ -384,6 +389,29 @@ func (s *ChecksService) ListCheckSuitesForRef(ctx context.Context, owner, repo,
 	return checkSuiteResults, resp, nil
 }
 
+// GetCheckSuiteForApp returns the check suite created by the GitHub App with
+// ID appID for a specific ref. It returns ErrCheckSuiteNotFound if the app
+// has no check suite for the ref.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/suites#list-check-suites-for-a-git-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-suites
+func (s *ChecksService) GetCheckSuiteForApp(ctx context.Context, owner, repo, ref string, appID int64) (*CheckSuite, *Response, error) {
+	opts := &ListCheckSuiteOptions{AppID: Ptr(int(appID))}
+	results, resp, err := s.ListCheckSuitesForRef(ctx, owner, repo, ref, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, suite := range results.CheckSuites {
+		if suite.GetApp().GetID() == appID {
+			return suite, resp, nil
+		}
+	}
+
+	return nil, resp, ErrCheckSuiteNotFound
+}
+
 // AutoTriggerCheck enables or disables automatic creation of CheckSuite events upon pushes to the repository.
 type AutoTriggerCheck struct {
 	AppID   *int64 `json:"app_id,omitempty"`  // The id of the GitHub App. (Required.)
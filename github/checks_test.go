@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -512,6 +513,84 @@ func TestChecksService_ListCheckSuiteForRef(t *testing.T) {
 	})
 }
 
+func TestChecksService_GetCheckSuiteForApp(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/master/check-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"app_id": "2"})
+		fmt.Fprint(w, `{"total_count":1,"check_suites":[{"id":1,"app":{"id":2}}]}`)
+	})
+
+	ctx := context.Background()
+	suite, _, err := client.Checks.GetCheckSuiteForApp(ctx, "o", "r", "master", 2)
+	if err != nil {
+		t.Errorf("Checks.GetCheckSuiteForApp returned error: %v", err)
+	}
+
+	want := &CheckSuite{ID: Ptr(int64(1)), App: &App{ID: Ptr(int64(2))}}
+	if !cmp.Equal(suite, want) {
+		t.Errorf("Checks.GetCheckSuiteForApp returned %+v, want %+v", suite, want)
+	}
+
+	const methodName = "GetCheckSuiteForApp"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Checks.GetCheckSuiteForApp(ctx, "\n", "\n", "\n", 2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Checks.GetCheckSuiteForApp(ctx, "o", "r", "master", 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestChecksService_GetCheckSuiteForApp_notFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/master/check-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"app_id": "2"})
+		fmt.Fprint(w, `{"total_count":0,"check_suites":[]}`)
+	})
+
+	ctx := context.Background()
+	suite, _, err := client.Checks.GetCheckSuiteForApp(ctx, "o", "r", "master", 2)
+	if !errors.Is(err, ErrCheckSuiteNotFound) {
+		t.Errorf("Checks.GetCheckSuiteForApp returned error %v, want %v", err, ErrCheckSuiteNotFound)
+	}
+	if suite != nil {
+		t.Errorf("Checks.GetCheckSuiteForApp returned %+v, want nil", suite)
+	}
+}
+
+func TestChecksService_GetCheckSuiteForApp_nilApp(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/master/check-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"app_id": "2"})
+		fmt.Fprint(w, `{"total_count":2,"check_suites":[{"id":3},{"id":1,"app":{"id":2}}]}`)
+	})
+
+	ctx := context.Background()
+	suite, _, err := client.Checks.GetCheckSuiteForApp(ctx, "o", "r", "master", 2)
+	if err != nil {
+		t.Errorf("Checks.GetCheckSuiteForApp returned error: %v", err)
+	}
+
+	want := &CheckSuite{ID: Ptr(int64(1)), App: &App{ID: Ptr(int64(2))}}
+	if !cmp.Equal(suite, want) {
+		t.Errorf("Checks.GetCheckSuiteForApp returned %+v, want %+v", suite, want)
+	}
+}
+
 func TestChecksService_SetCheckSuitePreferences(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
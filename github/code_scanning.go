@@ -6,10 +6,15 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -419,6 +424,71 @@ func (s *CodeScanningService) UploadSarif(ctx context.Context, owner, repo strin
 	return sarifID, resp, nil
 }
 
+// SarifUploadOptions specifies the parameters to the
+// CodeScanningService.UploadSarifFile method.
+type SarifUploadOptions struct {
+	// CommitSHA is the SHA of the commit to which the analysis applies.
+	// It is required.
+	CommitSHA string
+	// Ref is the full Git reference, such as "refs/heads/main", of the
+	// analyzed commit. It is required.
+	Ref         string
+	CheckoutURI string
+	StartedAt   *Timestamp
+	ToolName    string
+}
+
+// UploadSarifFile reads an uncompressed SARIF document from sarif,
+// compresses and encodes it as required by GitHub, and uploads it with
+// UploadSarif. Use GetSARIF with the returned ID to follow the processing
+// of the upload.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#upload-an-analysis-as-sarif-data
+//
+//meta:operation POST /repos/{owner}/{repo}/code-scanning/sarifs
+func (s *CodeScanningService) UploadSarifFile(ctx context.Context, owner, repo string, opts *SarifUploadOptions, sarif io.Reader) (*SarifID, *Response, error) {
+	if opts == nil || opts.CommitSHA == "" || opts.Ref == "" {
+		return nil, nil, errors.New("commit SHA and ref are required to upload a SARIF file")
+	}
+
+	encoded, err := encodeSarif(sarif)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	analysis := &SarifAnalysis{
+		CommitSHA: Ptr(opts.CommitSHA),
+		Ref:       Ptr(opts.Ref),
+		Sarif:     Ptr(encoded),
+		StartedAt: opts.StartedAt,
+	}
+	if opts.CheckoutURI != "" {
+		analysis.CheckoutURI = Ptr(opts.CheckoutURI)
+	}
+	if opts.ToolName != "" {
+		analysis.ToolName = Ptr(opts.ToolName)
+	}
+
+	return s.UploadSarif(ctx, owner, repo, analysis)
+}
+
+// encodeSarif returns the gzip-compressed, base64-encoded contents of r.
+func encodeSarif(r io.Reader) (string, error) {
+	var buf bytes.Buffer
+	enc := base64.NewEncoder(base64.StdEncoding, &buf)
+	zw := gzip.NewWriter(enc)
+	if _, err := io.Copy(zw, r); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 // SARIFUpload represents information about a SARIF upload.
 type SARIFUpload struct {
 	// `pending` files have not yet been processed, while `complete` means results from the SARIF have been stored.
@@ -529,7 +599,67 @@ type DeleteAnalysis struct {
 //
 //meta:operation DELETE /repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}
 func (s *CodeScanningService) DeleteAnalysis(ctx context.Context, owner, repo string, id int64) (*DeleteAnalysis, *Response, error) {
+	return s.deleteAnalysis(ctx, owner, repo, id, false)
+}
+
+// DeleteAnalysisChain deletes the analysis with the given ID, then keeps
+// deleting the next analysis in the chain returned by GitHub until there is
+// none left. It returns the IDs of the deleted analyses, in order, including
+// when an error stops the chain early.
+//
+// If confirmDelete is false, the chain follows next_analysis_url and stops
+// before the last analysis of a set, which GitHub refuses to delete without
+// confirmation. If it is true, every deletion is confirmed and the chain
+// follows confirm_delete_url, so the last analysis is deleted as well.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#delete-a-code-scanning-analysis-from-a-repository
+//
+//meta:operation DELETE /repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}
+func (s *CodeScanningService) DeleteAnalysisChain(ctx context.Context, owner, repo string, analysisID int64, confirmDelete bool) ([]int64, *Response, error) {
+	var deleted []int64
+	id := analysisID
+	for {
+		result, resp, err := s.deleteAnalysis(ctx, owner, repo, id, confirmDelete)
+		if err != nil {
+			return deleted, resp, err
+		}
+		deleted = append(deleted, id)
+
+		next := result.GetNextAnalysisURL()
+		if confirmDelete {
+			next = result.GetConfirmDeleteURL()
+		}
+		if next == "" {
+			return deleted, resp, nil
+		}
+
+		id, err = analysisIDFromURL(next)
+		if err != nil {
+			return deleted, resp, err
+		}
+	}
+}
+
+// analysisIDFromURL returns the analysis ID at the end of the path of a
+// next_analysis_url or confirm_delete_url.
+func analysisIDFromURL(u string) (int64, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return 0, err
+	}
+	_, idStr, _ := strings.Cut(parsed.Path, "/code-scanning/analyses/")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected analysis URL %q: %w", u, err)
+	}
+	return id, nil
+}
+
+func (s *CodeScanningService) deleteAnalysis(ctx context.Context, owner, repo string, id int64, confirmDelete bool) (*DeleteAnalysis, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses/%v", owner, repo, id)
+	if confirmDelete {
+		u += "?confirm_delete"
+	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
@@ -6,10 +6,14 @@
 package github
 
 import (
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -100,6 +104,73 @@ func TestCodeScanningService_UploadSarif(t *testing.T) {
 	})
 }
 
+func TestCodeScanningService_UploadSarifFile(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	const sarif = `{"version":"2.1.0","runs":[]}`
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(SarifAnalysis)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		if got, want := v.GetCommitSHA(), "abc"; got != want {
+			t.Errorf("Request commit_sha = %q, want %q", got, want)
+		}
+		if got, want := v.GetRef(), "refs/heads/main"; got != want {
+			t.Errorf("Request ref = %q, want %q", got, want)
+		}
+		if got, want := v.GetToolName(), "codeql-cli"; got != want {
+			t.Errorf("Request tool_name = %q, want %q", got, want)
+		}
+		if v.CheckoutURI != nil {
+			t.Errorf("Request checkout_uri = %q, want unset", v.GetCheckoutURI())
+		}
+
+		gz, err := base64.StdEncoding.DecodeString(v.GetSarif())
+		assertNilError(t, err)
+		zr, err := gzip.NewReader(strings.NewReader(string(gz)))
+		assertNilError(t, err)
+		decoded, err := io.ReadAll(zr)
+		assertNilError(t, err)
+		if got := string(decoded); got != sarif {
+			t.Errorf("Request sarif decodes to %q, want %q", got, sarif)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"testid"}`)
+	})
+
+	ctx := context.Background()
+	opts := &SarifUploadOptions{CommitSHA: "abc", Ref: "refs/heads/main", ToolName: "codeql-cli"}
+	sarifID, _, err := client.CodeScanning.UploadSarifFile(ctx, "o", "r", opts, strings.NewReader(sarif))
+	if err != nil {
+		t.Errorf("CodeScanning.UploadSarifFile returned error: %v", err)
+	}
+
+	want := &SarifID{ID: Ptr("testid")}
+	if !cmp.Equal(sarifID, want) {
+		t.Errorf("CodeScanning.UploadSarifFile returned %+v, want %+v", sarifID, want)
+	}
+}
+
+func TestCodeScanningService_UploadSarifFile_missingOptions(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, opts := range []*SarifUploadOptions{nil, {Ref: "refs/heads/main"}, {CommitSHA: "abc"}} {
+		_, resp, err := client.CodeScanning.UploadSarifFile(ctx, "o", "r", opts, strings.NewReader("{}"))
+		if err == nil {
+			t.Errorf("CodeScanning.UploadSarifFile(%+v) returned nil error, want error", opts)
+		}
+		if resp != nil {
+			t.Errorf("CodeScanning.UploadSarifFile(%+v) returned response %+v, want nil", opts, resp)
+		}
+	}
+}
+
 func TestCodeScanningService_GetSARIF(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -1358,6 +1429,85 @@ func TestCodeScanningService_DeleteAnalysis(t *testing.T) {
 	})
 }
 
+func TestCodeScanningService_DeleteAnalysisChain(t *testing.T) {
+	t.Parallel()
+
+	for _, confirm := range []bool{false, true} {
+		t.Run(fmt.Sprintf("confirmDelete=%v", confirm), func(t *testing.T) {
+			t.Parallel()
+			client, mux, serverURL := setup(t)
+
+			wantQuery := ""
+			if confirm {
+				wantQuery = "confirm_delete"
+			}
+			base := serverURL + "/api-v3/repos/o/r/code-scanning/analyses/"
+			mux.HandleFunc("/repos/o/r/code-scanning/analyses/40", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				if r.URL.RawQuery != wantQuery {
+					t.Errorf("Request query = %q, want %q", r.URL.RawQuery, wantQuery)
+				}
+				fmt.Fprintf(w, `{"next_analysis_url":"%[1]v41","confirm_delete_url":"%[1]v41?confirm_delete"}`, base)
+			})
+			mux.HandleFunc("/repos/o/r/code-scanning/analyses/41", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				if r.URL.RawQuery != wantQuery {
+					t.Errorf("Request query = %q, want %q", r.URL.RawQuery, wantQuery)
+				}
+				if confirm {
+					fmt.Fprintf(w, `{"next_analysis_url":null,"confirm_delete_url":"%v42?confirm_delete"}`, base)
+				} else {
+					fmt.Fprint(w, `{"next_analysis_url":null,"confirm_delete_url":null}`)
+				}
+			})
+			mux.HandleFunc("/repos/o/r/code-scanning/analyses/42", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				if !confirm {
+					t.Error("unexpected deletion of analysis 42 without confirmation")
+				}
+				fmt.Fprint(w, `{"next_analysis_url":null,"confirm_delete_url":null}`)
+			})
+
+			ctx := context.Background()
+			deleted, _, err := client.CodeScanning.DeleteAnalysisChain(ctx, "o", "r", 40, confirm)
+			if err != nil {
+				t.Errorf("CodeScanning.DeleteAnalysisChain returned error: %v", err)
+			}
+
+			want := []int64{40, 41}
+			if confirm {
+				want = append(want, 42)
+			}
+			if !cmp.Equal(deleted, want) {
+				t.Errorf("CodeScanning.DeleteAnalysisChain returned %v, want %v", deleted, want)
+			}
+		})
+	}
+}
+
+func TestCodeScanningService_DeleteAnalysisChain_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses/40", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"next_analysis_url":"https://api.github.com/repos/o/r/code-scanning/analyses/41"}`)
+	})
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses/41", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	ctx := context.Background()
+	deleted, _, err := client.CodeScanning.DeleteAnalysisChain(ctx, "o", "r", 40, false)
+	if err == nil {
+		t.Error("CodeScanning.DeleteAnalysisChain returned nil error, want error")
+	}
+	if want := []int64{40}; !cmp.Equal(deleted, want) {
+		t.Errorf("CodeScanning.DeleteAnalysisChain returned %v, want %v", deleted, want)
+	}
+}
+
 func TestCodeScanningService_ListCodeQLDatabases(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
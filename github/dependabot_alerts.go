@@ -96,7 +96,9 @@ type ListAlertsOptions struct {
 	Ecosystem *string `url:"ecosystem,omitempty"`
 	Package   *string `url:"package,omitempty"`
 	Scope     *string `url:"scope,omitempty"`
-	Sort      *string `url:"sort,omitempty"`
+	// Sort can be one of: "created", "updated", "epss_percentage". Default: "created".
+	Sort *string `url:"sort,omitempty"`
+	// Direction can be one of: "asc", "desc". Default: "desc".
 	Direction *string `url:"direction,omitempty"`
 
 	ListOptions
@@ -133,6 +135,36 @@ func (s *DependabotService) ListRepoAlerts(ctx context.Context, owner, repo stri
 	return s.listAlerts(ctx, url, opts)
 }
 
+// ListRepoAlertsBySeverity lists all Dependabot alerts of a repository,
+// following pagination, and groups them by the severity of their security
+// advisory: "low", "medium", "high" or "critical". Within each severity,
+// alerts are sorted by descending EPSS percentage.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/alerts#list-dependabot-alerts-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/dependabot/alerts
+func (s *DependabotService) ListRepoAlertsBySeverity(ctx context.Context, owner, repo string) (map[string][]*DependabotAlert, *Response, error) {
+	bySeverity := make(map[string][]*DependabotAlert)
+	opts := &ListAlertsOptions{
+		Sort:        Ptr("epss_percentage"),
+		Direction:   Ptr("desc"),
+		ListOptions: ListOptions{PerPage: 100},
+	}
+	for {
+		alerts, resp, err := s.ListRepoAlerts(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, alert := range alerts {
+			severity := alert.GetSecurityAdvisory().GetSeverity()
+			bySeverity[severity] = append(bySeverity[severity], alert)
+		}
+		if !nextAlertsPage(resp, &opts.ListOptions, &opts.ListCursorOptions) {
+			return bySeverity, resp, nil
+		}
+	}
+}
+
 // ListOrgAlerts lists all Dependabot alerts of an organization.
 //
 // GitHub API docs: https://docs.github.com/rest/dependabot/alerts#list-dependabot-alerts-for-an-organization
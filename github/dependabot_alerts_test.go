@@ -55,6 +55,81 @@ func TestDependabotService_ListRepoAlerts(t *testing.T) {
 	})
 }
 
+func TestDependabotService_ListRepoAlerts_sort(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sort": "epss_percentage", "direction": "desc"})
+		fmt.Fprint(w, `[{"number":1,"security_advisory":{"epss":{"percentage":0.5,"percentile":0.9}}}]`)
+	})
+
+	opts := &ListAlertsOptions{Sort: Ptr("epss_percentage"), Direction: Ptr("desc")}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListRepoAlerts(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{
+		{Number: Ptr(1), SecurityAdvisory: &DependabotSecurityAdvisory{EPSS: &AdvisoryEPSS{Percentage: 0.5, Percentile: 0.9}}},
+	}
+	if !cmp.Equal(alerts, want) {
+		t.Errorf("Dependabot.ListRepoAlerts returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestDependabotService_ListRepoAlertsBySeverity(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"sort": "epss_percentage", "direction": "desc", "per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/dependabot/alerts?per_page=100&after=c1>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"number":1,"security_advisory":{"severity":"high"}},{"number":2,"security_advisory":{"severity":"low"}}]`)
+		case "c1":
+			fmt.Fprint(w, `[{"number":3,"security_advisory":{"severity":"high"}}]`)
+		default:
+			t.Errorf("unexpected after %q", r.FormValue("after"))
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Dependabot.ListRepoAlertsBySeverity(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoAlertsBySeverity returned error: %v", err)
+	}
+
+	alert := func(number int, severity string) *DependabotAlert {
+		return &DependabotAlert{Number: Ptr(number), SecurityAdvisory: &DependabotSecurityAdvisory{Severity: Ptr(severity)}}
+	}
+	want := map[string][]*DependabotAlert{
+		"high": {alert(1, "high"), alert(3, "high")},
+		"low":  {alert(2, "low")},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Dependabot.ListRepoAlertsBySeverity returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListRepoAlertsBySeverity"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListRepoAlertsBySeverity(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListRepoAlertsBySeverity(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestDependabotService_GetRepoAlert(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -181,6 +181,73 @@ func (s *DependabotService) CreateOrUpdateOrgSecret(ctx context.Context, org str
 	return s.client.Do(ctx, req, nil)
 }
 
+// newDependabotEncryptedSecret encrypts plaintext with publicKey for a
+// Dependabot secret named name.
+func newDependabotEncryptedSecret(publicKey *PublicKey, name, plaintext string) (*DependabotEncryptedSecret, error) {
+	encrypted, err := sealSecret(publicKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DependabotEncryptedSecret{
+		Name:           name,
+		KeyID:          publicKey.GetKeyID(),
+		EncryptedValue: encrypted,
+	}, nil
+}
+
+// PutRepoSecret creates or updates a repository Dependabot secret with a
+// plaintext value. It fetches the repository public key with GetRepoPublicKey
+// and encrypts the value with it before calling CreateOrUpdateRepoSecret.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#create-or-update-a-repository-secret
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#get-a-repository-public-key
+//
+//meta:operation GET /repos/{owner}/{repo}/dependabot/secrets/public-key
+//meta:operation PUT /repos/{owner}/{repo}/dependabot/secrets/{secret_name}
+func (s *DependabotService) PutRepoSecret(ctx context.Context, owner, repo, name, plaintext string) (*Response, error) {
+	publicKey, resp, err := s.GetRepoPublicKey(ctx, owner, repo)
+	if err != nil {
+		return resp, err
+	}
+
+	eSecret, err := newDependabotEncryptedSecret(publicKey, name, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+
+// PutOrgSecret creates or updates an organization Dependabot secret with a
+// plaintext value. It fetches the organization public key with
+// GetOrgPublicKey and encrypts the value with it before calling
+// CreateOrUpdateOrgSecret.
+//
+// Visibility is one of "all", "private" or "selected". selectedRepoIDs is only
+// used when visibility is "selected".
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#create-or-update-an-organization-secret
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#get-an-organization-public-key
+//
+//meta:operation GET /orgs/{org}/dependabot/secrets/public-key
+//meta:operation PUT /orgs/{org}/dependabot/secrets/{secret_name}
+func (s *DependabotService) PutOrgSecret(ctx context.Context, org, name, plaintext, visibility string, selectedRepoIDs DependabotSecretsSelectedRepoIDs) (*Response, error) {
+	publicKey, resp, err := s.GetOrgPublicKey(ctx, org)
+	if err != nil {
+		return resp, err
+	}
+
+	eSecret, err := newDependabotEncryptedSecret(publicKey, name, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	eSecret.Visibility = visibility
+	eSecret.SelectedRepositoryIDs = selectedRepoIDs
+
+	return s.CreateOrUpdateOrgSecret(ctx, org, eSecret)
+}
+
 func (s *DependabotService) deleteSecret(ctx context.Context, url string) (*Response, error) {
 	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
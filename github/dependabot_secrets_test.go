@@ -6,8 +6,12 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -380,6 +384,109 @@ func TestDependabotService_CreateOrUpdateOrgSecret(t *testing.T) {
 	})
 }
 
+func TestDependabotService_PutRepoSecret(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	publicKey, privateKey := testSecretKeyPair(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		keyID, plaintext := testOpenSecret(t, r, publicKey, privateKey)
+		if keyID != "1234" {
+			t.Errorf("key_id = %q, want %q", keyID, "1234")
+		}
+		if plaintext != "s3cr3t" {
+			t.Errorf("decrypted value = %q, want %q", plaintext, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Dependabot.PutRepoSecret(ctx, "o", "r", "NAME", "s3cr3t")
+	if err != nil {
+		t.Errorf("Dependabot.PutRepoSecret returned error: %v", err)
+	}
+
+	const methodName = "PutRepoSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Dependabot.PutRepoSecret(ctx, "\n", "\n", "\n", "s3cr3t")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Dependabot.PutRepoSecret(ctx, "o", "r", "NAME", "s3cr3t")
+	})
+}
+
+func TestDependabotService_PutRepoSecret_missingKeyID(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	publicKey, _ := testSecretKeyPair(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+
+	ctx := context.Background()
+	if _, err := client.Dependabot.PutRepoSecret(ctx, "o", "r", "NAME", "s3cr3t"); err == nil {
+		t.Error("Dependabot.PutRepoSecret returned nil error for a public key without key ID")
+	}
+}
+
+func TestDependabotService_PutOrgSecret(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	publicKey, privateKey := testSecretKeyPair(t)
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key_id":"1234","key":%q}`, base64.StdEncoding.EncodeToString(publicKey[:]))
+	})
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, err := io.ReadAll(r.Body)
+		assertNilError(t, err)
+		var v struct {
+			Visibility            string   `json:"visibility"`
+			SelectedRepositoryIDs []string `json:"selected_repository_ids"`
+		}
+		assertNilError(t, json.Unmarshal(body, &v))
+		if v.Visibility != "selected" || !cmp.Equal(v.SelectedRepositoryIDs, []string{"1296269", "1269280"}) {
+			t.Errorf("Request body = %s, want selected visibility with repository IDs", body)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		keyID, plaintext := testOpenSecret(t, r, publicKey, privateKey)
+		if keyID != "1234" {
+			t.Errorf("key_id = %q, want %q", keyID, "1234")
+		}
+		if plaintext != "s3cr3t" {
+			t.Errorf("decrypted value = %q, want %q", plaintext, "s3cr3t")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Dependabot.PutOrgSecret(ctx, "o", "NAME", "s3cr3t", "selected", DependabotSecretsSelectedRepoIDs{1296269, 1269280})
+	if err != nil {
+		t.Errorf("Dependabot.PutOrgSecret returned error: %v", err)
+	}
+
+	const methodName = "PutOrgSecret"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Dependabot.PutOrgSecret(ctx, "\n", "\n", "s3cr3t", "all", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Dependabot.PutOrgSecret(ctx, "o", "NAME", "s3cr3t", "selected", DependabotSecretsSelectedRepoIDs{1296269, 1269280})
+	})
+}
+
 func TestDependabotService_ListSelectedReposForOrgSecret(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
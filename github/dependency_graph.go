@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 type DependencyGraphService service
@@ -80,3 +81,20 @@ func (s *DependencyGraphService) GetSBOM(ctx context.Context, owner, repo string
 
 	return sbom, resp, nil
 }
+
+// ExportSBOMJSON writes the software bill of materials for a repository to
+// w as the raw SPDX JSON document returned by GitHub, without decoding it.
+//
+// GitHub API docs: https://docs.github.com/rest/dependency-graph/sboms#export-a-software-bill-of-materials-sbom-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/dependency-graph/sbom
+func (s *DependencyGraphService) ExportSBOMJSON(ctx context.Context, owner, repo string, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DependencyChange represents a dependency added or removed between two
+// commits, as reported by the dependency review API.
+type DependencyChange struct {
+	// ChangeType is either "added" or "removed".
+	ChangeType          *string                          `json:"change_type,omitempty"`
+	Manifest            *string                          `json:"manifest,omitempty"`
+	Ecosystem           *string                          `json:"ecosystem,omitempty"`
+	Name                *string                          `json:"name,omitempty"`
+	Version             *string                          `json:"version,omitempty"`
+	PackageURL          *string                          `json:"package_url,omitempty"`
+	License             *string                          `json:"license,omitempty"`
+	SourceRepositoryURL *string                          `json:"source_repository_url,omitempty"`
+	Vulnerabilities     []*DependencyChangeVulnerability `json:"vulnerabilities,omitempty"`
+	// Scope is either "unknown", "runtime" or "development".
+	Scope *string `json:"scope,omitempty"`
+}
+
+// DependencyChangeVulnerability represents a known vulnerability of a
+// DependencyChange.
+type DependencyChangeVulnerability struct {
+	Severity        *string `json:"severity,omitempty"`
+	AdvisoryGHSAID  *string `json:"advisory_ghsa_id,omitempty"`
+	AdvisorySummary *string `json:"advisory_summary,omitempty"`
+	AdvisoryURL     *string `json:"advisory_url,omitempty"`
+}
+
+// Compare lists the dependency changes between base and head, which can be
+// branch names or commit SHAs. To compare with a branch in a fork of the
+// repository, use the "<USERNAME>:branch" format for head.
+//
+// GitHub API docs: https://docs.github.com/rest/dependency-graph/dependency-review#get-a-diff-of-the-dependencies-between-commits
+//
+//meta:operation GET /repos/{owner}/{repo}/dependency-graph/compare/{basehead}
+func (s *DependencyGraphService) Compare(ctx context.Context, owner, repo, base, head string) ([]*DependencyChange, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/compare/%v...%v", owner, repo, url.QueryEscape(base), url.QueryEscape(head))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*DependencyChange
+	resp, err := s.client.Do(ctx, req, &changes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return changes, resp, nil
+}
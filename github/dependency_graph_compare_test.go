@@ -0,0 +1,82 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependencyGraphService_Compare(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/compare/main...u:feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"change_type": "added",
+			"manifest": "package.json",
+			"ecosystem": "npm",
+			"name": "lodash",
+			"version": "4.17.15",
+			"package_url": "pkg:npm/lodash@4.17.15",
+			"license": "MIT",
+			"source_repository_url": "https://github.com/lodash/lodash",
+			"scope": "runtime",
+			"vulnerabilities": [{
+				"severity": "high",
+				"advisory_ghsa_id": "GHSA-p6mc-m468-83gw",
+				"advisory_summary": "Prototype Pollution in lodash",
+				"advisory_url": "https://github.com/advisories/GHSA-p6mc-m468-83gw"
+			}]
+		}]`)
+	})
+
+	ctx := context.Background()
+	changes, _, err := client.DependencyGraph.Compare(ctx, "o", "r", "main", "u:feature")
+	if err != nil {
+		t.Errorf("DependencyGraph.Compare returned error: %v", err)
+	}
+
+	want := []*DependencyChange{{
+		ChangeType:          Ptr("added"),
+		Manifest:            Ptr("package.json"),
+		Ecosystem:           Ptr("npm"),
+		Name:                Ptr("lodash"),
+		Version:             Ptr("4.17.15"),
+		PackageURL:          Ptr("pkg:npm/lodash@4.17.15"),
+		License:             Ptr("MIT"),
+		SourceRepositoryURL: Ptr("https://github.com/lodash/lodash"),
+		Scope:               Ptr("runtime"),
+		Vulnerabilities: []*DependencyChangeVulnerability{{
+			Severity:        Ptr("high"),
+			AdvisoryGHSAID:  Ptr("GHSA-p6mc-m468-83gw"),
+			AdvisorySummary: Ptr("Prototype Pollution in lodash"),
+			AdvisoryURL:     Ptr("https://github.com/advisories/GHSA-p6mc-m468-83gw"),
+		}},
+	}}
+	if !cmp.Equal(changes, want) {
+		t.Errorf("DependencyGraph.Compare returned %+v, want %+v", changes, want)
+	}
+
+	const methodName = "Compare"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DependencyGraph.Compare(ctx, "\n", "\n", "main", "feature")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DependencyGraph.Compare(ctx, "o", "r", "main", "u:feature")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -77,3 +78,36 @@ func TestDependencyGraphService_GetSBOM(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestDependencyGraphService_ExportSBOMJSON(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	const sbom = `{"sbom":{"SPDXID":"SPDXRef-DOCUMENT","spdxVersion":"SPDX-2.3","packages":[{"SPDXID":"SPDXRef-Repository","name":"github/example"}]}}`
+
+	mux.HandleFunc("/repos/owner/repo/dependency-graph/sbom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, sbom)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.DependencyGraph.ExportSBOMJSON(ctx, "owner", "repo", &buf)
+	if err != nil {
+		t.Errorf("DependencyGraph.ExportSBOMJSON returned error: %v", err)
+	}
+
+	if got := buf.String(); got != sbom {
+		t.Errorf("DependencyGraph.ExportSBOMJSON wrote %q, want %q", got, sbom)
+	}
+
+	const methodName = "ExportSBOMJSON"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.DependencyGraph.ExportSBOMJSON(ctx, "\n", "\n", &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.DependencyGraph.ExportSBOMJSON(ctx, "owner", "repo", &buf)
+	})
+}
@@ -7,6 +7,8 @@ package github
 
 import (
 	"context"
+	"maps"
+	"strings"
 )
 
 // EmojisService provides access to emoji-related functions in the GitHub API.
@@ -32,6 +34,64 @@ func (s *EmojisService) List(ctx context.Context) (map[string]string, *Response,
 	return emoji, resp, nil
 }
 
+// ListCached returns the emojis available to use on GitHub, fetching them
+// on the first call and returning a cached copy afterwards. The returned
+// Response is nil when the emojis are served from the cache. It is safe for
+// concurrent use; concurrent first calls fetch the emojis only once.
+//
+// GitHub API docs: https://docs.github.com/rest/emojis/emojis#get-emojis
+//
+//meta:operation GET /emojis
+func (s *EmojisService) ListCached(ctx context.Context) (map[string]string, *Response, error) {
+	s.client.emojiMu.Lock()
+	defer s.client.emojiMu.Unlock()
+
+	if s.client.emojis != nil {
+		return maps.Clone(s.client.emojis), nil, nil
+	}
+	return s.refreshLocked(ctx)
+}
+
+// Refresh fetches the emojis available to use on GitHub and replaces the
+// ones cached by ListCached.
+//
+// GitHub API docs: https://docs.github.com/rest/emojis/emojis#get-emojis
+//
+//meta:operation GET /emojis
+func (s *EmojisService) Refresh(ctx context.Context) (map[string]string, *Response, error) {
+	s.client.emojiMu.Lock()
+	defer s.client.emojiMu.Unlock()
+
+	return s.refreshLocked(ctx)
+}
+
+// refreshLocked fetches the emojis and caches them. The caller must hold
+// s.client.emojiMu.
+func (s *EmojisService) refreshLocked(ctx context.Context) (map[string]string, *Response, error) {
+	emojis, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	if emojis == nil {
+		emojis = map[string]string{}
+	}
+
+	s.client.emojis = emojis
+	return maps.Clone(emojis), resp, nil
+}
+
+// EmojiURL returns the image URL of the emoji with the given shortcode, such
+// as "+1" or ":+1:", and whether it was found. It only consults the emojis
+// cached by EmojisService.ListCached or EmojisService.Refresh, so one of them
+// must have been called first.
+func (c *Client) EmojiURL(shortcode string) (string, bool) {
+	c.emojiMu.Lock()
+	defer c.emojiMu.Unlock()
+
+	url, ok := c.emojis[strings.Trim(shortcode, ":")]
+	return url, ok
+}
+
 // ListEmojis returns the emojis available to use on GitHub.
 //
 // Deprecated: Use EmojisService.List instead.
@@ -43,3 +43,61 @@ func TestEmojisService_List(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestEmojisService_ListCached(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var calls int
+	mux.HandleFunc("/emojis", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		fmt.Fprintf(w, `{"+1": "+1.png", "tada": "tada-%v.png"}`, calls)
+	})
+
+	if _, ok := client.EmojiURL("+1"); ok {
+		t.Error("EmojiURL before ListCached returned ok = true, want false")
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		emojis, _, err := client.Emojis.ListCached(ctx)
+		if err != nil {
+			t.Fatalf("Emojis.ListCached returned error: %v", err)
+		}
+		want := map[string]string{"+1": "+1.png", "tada": "tada-1.png"}
+		if !cmp.Equal(emojis, want) {
+			t.Errorf("Emojis.ListCached returned %+v, want %+v", emojis, want)
+		}
+		// Modifying the returned map must not affect the cache.
+		delete(emojis, "+1")
+	}
+
+	for _, shortcode := range []string{"+1", ":+1:"} {
+		if got, ok := client.EmojiURL(shortcode); !ok || got != "+1.png" {
+			t.Errorf("EmojiURL(%q) = %q, %v, want %q, true", shortcode, got, ok, "+1.png")
+		}
+	}
+	if _, ok := client.EmojiURL("nope"); ok {
+		t.Error("EmojiURL(nope) returned ok = true, want false")
+	}
+	if calls != 1 {
+		t.Errorf("GET /emojis called %v times, want 1", calls)
+	}
+
+	if _, _, err := client.Emojis.Refresh(ctx); err != nil {
+		t.Fatalf("Emojis.Refresh returned error: %v", err)
+	}
+	if got, _ := client.EmojiURL("tada"); got != "tada-2.png" {
+		t.Errorf("EmojiURL(tada) after Refresh = %q, want %q", got, "tada-2.png")
+	}
+
+	const methodName = "Refresh"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Emojis.Refresh(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -17,6 +17,11 @@ type ListOrganizations struct {
 }
 
 // EnterpriseRunnerGroup represents a self-hosted runner group configured in an enterprise.
+//
+// Unlike organization runner groups, enterprise runner groups are shared with
+// organizations rather than individual repositories. Use
+// ListOrganizationAccessRunnerGroup and SetOrganizationAccessRunnerGroup to
+// manage which organizations can use the group.
 type EnterpriseRunnerGroup struct {
 	ID                           *int64   `json:"id,omitempty"`
 	Name                         *string  `json:"name,omitempty"`
@@ -10,7 +10,7 @@ import (
 	"fmt"
 )
 
-// GetAuditLog gets the audit-log entries for an organization.
+// GetAuditLog gets the audit-log entries for an enterprise.
 //
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/audit-log#get-the-audit-log-for-an-enterprise
 //
@@ -35,3 +35,53 @@ func (s *EnterpriseService) GetAuditLog(ctx context.Context, enterprise string,
 
 	return auditEntries, resp, nil
 }
+
+// StreamAuditLog streams the audit-log entries for an enterprise, following
+// the cursor pagination of GetAuditLog until all pages have been read.
+//
+// Entries are sent on the first returned channel, which is closed once
+// streaming stops. If fetching a page fails or ctx is done, the error is sent
+// on the second channel before both channels are closed. Callers should drain
+// the entries channel and then check the error channel.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/audit-log#get-the-audit-log-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/audit-log
+func (s *EnterpriseService) StreamAuditLog(ctx context.Context, enterprise string, opts *GetAuditLogOptions) (<-chan *AuditEntry, <-chan error) {
+	entries := make(chan *AuditEntry)
+	errc := make(chan error, 1)
+
+	var o GetAuditLogOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	go func() {
+		defer close(entries)
+		defer close(errc)
+
+		for {
+			page, resp, err := s.GetAuditLog(ctx, enterprise, &o)
+			if err != nil {
+				errc <- err
+				return
+			}
+
+			for _, entry := range page {
+				select {
+				case entries <- entry:
+				case <-ctx.Done():
+					errc <- ctx.Err()
+					return
+				}
+			}
+
+			if resp.After == "" {
+				return
+			}
+			o.After = resp.After
+		}
+	}()
+
+	return entries, errc
+}
@@ -11,6 +11,8 @@ import (
 	"net/http"
 	"testing"
 	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestEnterpriseService_GetAuditLog(t *testing.T) {
@@ -92,3 +94,66 @@ func TestEnterpriseService_GetAuditLog(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestEnterpriseService_StreamAuditLog(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"phrase": "action:repo", "include": "all", "order": "asc"})
+			w.Header().Set("Link", `<https://api.github.com/enterprises/e/audit-log?after=c2&before=>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d1"},{"_document_id":"d2"}]`)
+		case "c2":
+			testFormValues(t, r, values{"phrase": "action:repo", "include": "all", "order": "asc", "after": "c2"})
+			fmt.Fprint(w, `[{"_document_id":"d3"}]`)
+		default:
+			t.Errorf("unexpected after cursor %q", r.FormValue("after"))
+		}
+	})
+
+	opts := &GetAuditLogOptions{
+		Phrase:  Ptr("action:repo"),
+		Include: Ptr("all"),
+		Order:   Ptr("asc"),
+	}
+	ctx := context.Background()
+	entries, errc := client.Enterprise.StreamAuditLog(ctx, "e", opts)
+
+	var got []string
+	for entry := range entries {
+		got = append(got, entry.GetDocumentID())
+	}
+	if err := <-errc; err != nil {
+		t.Errorf("Enterprise.StreamAuditLog returned error: %v", err)
+	}
+
+	want := []string{"d1", "d2", "d3"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Enterprise.StreamAuditLog returned %+v, want %+v", got, want)
+	}
+	if opts.After != "" {
+		t.Errorf("Enterprise.StreamAuditLog modified opts.After = %q, want empty", opts.After)
+	}
+}
+
+func TestEnterpriseService_StreamAuditLog_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	entries, errc := client.Enterprise.StreamAuditLog(ctx, "e", nil)
+	for entry := range entries {
+		t.Errorf("Enterprise.StreamAuditLog returned unexpected entry %+v", entry)
+	}
+	if err := <-errc; err == nil {
+		t.Error("Enterprise.StreamAuditLog returned nil error, want error")
+	}
+}
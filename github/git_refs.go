@@ -7,11 +7,16 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
 )
 
+// ErrRefChanged is returned by UpdateRefWithLease when the ref no longer
+// points at the expected SHA.
+var ErrRefChanged = errors.New("ref does not point at the expected SHA")
+
 // Reference represents a GitHub reference.
 type Reference struct {
 	Ref    *string    `json:"ref"`
@@ -168,6 +173,30 @@ func (s *GitService) UpdateRef(ctx context.Context, owner string, repo string, r
 	return r, resp, nil
 }
 
+// UpdateRefWithLease force-updates ref to ref.Object.SHA, but only if the ref
+// currently points at expectedSHA, like "git push --force-with-lease". If it
+// points elsewhere, ErrRefChanged is returned and the ref is left unchanged.
+//
+// The check and the update are separate requests, so this narrows but does
+// not fully close the window for a concurrent push to be overwritten.
+//
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+func (s *GitService) UpdateRefWithLease(ctx context.Context, owner, repo string, ref *Reference, expectedSHA string) (*Reference, *Response, error) {
+	current, resp, err := s.GetRef(ctx, owner, repo, ref.GetRef())
+	if err != nil {
+		return nil, resp, err
+	}
+	if current.GetObject().GetSHA() != expectedSHA {
+		return nil, resp, ErrRefChanged
+	}
+
+	return s.UpdateRef(ctx, owner, repo, ref, true)
+}
+
 // DeleteRef deletes a ref from a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/git/refs#delete-a-reference
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -236,6 +237,31 @@ func TestGitService_ListMatchingRefs_multipleRefs(t *testing.T) {
 	})
 }
 
+func TestGitService_ListMatchingRefs_prefix(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/matching-refs/tags/v1.", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"ref":"refs/tags/v1.0.0"},{"ref":"refs/tags/v1.1.0"}]`)
+	})
+
+	opts := &ReferenceListOptions{Ref: "tags/v1."}
+	ctx := context.Background()
+	refs, _, err := client.Git.ListMatchingRefs(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Git.ListMatchingRefs returned error: %v", err)
+	}
+
+	want := []*Reference{
+		{Ref: Ptr("refs/tags/v1.0.0")},
+		{Ref: Ptr("refs/tags/v1.1.0")},
+	}
+	if !cmp.Equal(refs, want) {
+		t.Errorf("Git.ListMatchingRefs returned %+v, want %+v", refs, want)
+	}
+}
+
 func TestGitService_ListMatchingRefs_noRefs(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -552,6 +578,62 @@ func TestGitService_UpdateRef(t *testing.T) {
 	})
 }
 
+func TestGitService_UpdateRefWithLease(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"old"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"new","force":true}`+"\n")
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"new"}}`)
+	})
+
+	ctx := context.Background()
+	input := &Reference{Ref: Ptr("refs/heads/b"), Object: &GitObject{SHA: Ptr("new")}}
+	ref, _, err := client.Git.UpdateRefWithLease(ctx, "o", "r", input, "old")
+	if err != nil {
+		t.Errorf("Git.UpdateRefWithLease returned error: %v", err)
+	}
+
+	want := &Reference{Ref: Ptr("refs/heads/b"), Object: &GitObject{SHA: Ptr("new")}}
+	if !cmp.Equal(ref, want) {
+		t.Errorf("Git.UpdateRefWithLease returned %+v, want %+v", ref, want)
+	}
+
+	const methodName = "UpdateRefWithLease"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.UpdateRefWithLease(ctx, "\n", "\n", input, "old")
+		return err
+	})
+}
+
+func TestGitService_UpdateRefWithLease_changed(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/b","object":{"sha":"other"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Git.UpdateRefWithLease updated a ref that had changed")
+	})
+
+	ctx := context.Background()
+	input := &Reference{Ref: Ptr("refs/heads/b"), Object: &GitObject{SHA: Ptr("new")}}
+	ref, _, err := client.Git.UpdateRefWithLease(ctx, "o", "r", input, "old")
+	if !errors.Is(err, ErrRefChanged) {
+		t.Errorf("Git.UpdateRefWithLease returned error %v, want %v", err, ErrRefChanged)
+	}
+	if ref != nil {
+		t.Errorf("Git.UpdateRefWithLease returned %+v, want nil", ref)
+	}
+}
+
 func TestGitService_DeleteRef(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
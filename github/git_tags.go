@@ -86,3 +86,51 @@ func (s *GitService) CreateTag(ctx context.Context, owner string, repo string, t
 
 	return t, resp, nil
 }
+
+// ResolvedTag describes a tag and the commit it points to, regardless of
+// whether it is a lightweight or an annotated tag.
+type ResolvedTag struct {
+	TagName   string
+	CommitSHA string
+	// Tagger and Message are only set for annotated tags.
+	Tagger  *CommitAuthor
+	Message string
+}
+
+// ResolveTag looks up the tag named tag and returns the commit it points
+// to. Annotated tags are dereferenced, following tags of tags if needed,
+// and their tagger and message are included in the result.
+//
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/tags#get-a-tag
+//
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation GET /repos/{owner}/{repo}/git/tags/{tag_sha}
+func (s *GitService) ResolveTag(ctx context.Context, owner, repo, tag string) (*ResolvedTag, *Response, error) {
+	ref, resp, err := s.GetRef(ctx, owner, repo, "tags/"+tag)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resolved := &ResolvedTag{TagName: tag}
+	obj := ref.GetObject()
+	for obj.GetType() == "tag" {
+		t, r, err := s.GetTag(ctx, owner, repo, obj.GetSHA())
+		if err != nil {
+			return nil, r, err
+		}
+		resp = r
+		if resolved.Tagger == nil {
+			resolved.Tagger = t.Tagger
+			resolved.Message = t.GetMessage()
+		}
+		obj = t.GetObject()
+	}
+
+	if obj.GetType() != "commit" {
+		return nil, resp, fmt.Errorf("tag %v points to a %v, not a commit", tag, obj.GetType())
+	}
+	resolved.CommitSHA = obj.GetSHA()
+
+	return resolved, resp, nil
+}
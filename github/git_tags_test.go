@@ -187,3 +187,83 @@ func TestCreateTagRequest_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestGitService_ResolveTag_lightweight(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/tags/v1","object":{"type":"commit","sha":"c1"}}`)
+	})
+
+	ctx := context.Background()
+	tag, _, err := client.Git.ResolveTag(ctx, "o", "r", "v1")
+	if err != nil {
+		t.Errorf("Git.ResolveTag returned error: %v", err)
+	}
+
+	want := &ResolvedTag{TagName: "v1", CommitSHA: "c1"}
+	if !cmp.Equal(tag, want) {
+		t.Errorf("Git.ResolveTag returned %+v, want %+v", tag, want)
+	}
+
+	const methodName = "ResolveTag"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.ResolveTag(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.ResolveTag(ctx, "o", "r", "v1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGitService_ResolveTag_annotated(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/tags/v2","object":{"type":"tag","sha":"t2"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/tags/t2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":"v2","sha":"t2","message":"Release v2","tagger":{"name":"n","email":"e"},"object":{"type":"commit","sha":"c2"}}`)
+	})
+
+	ctx := context.Background()
+	tag, _, err := client.Git.ResolveTag(ctx, "o", "r", "v2")
+	if err != nil {
+		t.Errorf("Git.ResolveTag returned error: %v", err)
+	}
+
+	want := &ResolvedTag{
+		TagName:   "v2",
+		CommitSHA: "c2",
+		Tagger:    &CommitAuthor{Name: Ptr("n"), Email: Ptr("e")},
+		Message:   "Release v2",
+	}
+	if !cmp.Equal(tag, want) {
+		t.Errorf("Git.ResolveTag returned %+v, want %+v", tag, want)
+	}
+}
+
+func TestGitService_ResolveTag_notCommit(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/tags/v3","object":{"type":"tree","sha":"t3"}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Git.ResolveTag(ctx, "o", "r", "v3"); err == nil {
+		t.Error("Git.ResolveTag returned nil error, want error")
+	}
+}
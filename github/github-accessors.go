@@ -1510,6 +1510,62 @@ func (a *AutoTriggerCheck) GetSetting() bool {
 	return *a.Setting
 }
 
+// GetAuthor returns the Author field.
+func (b *BlameCommit) GetAuthor() *CommitAuthor {
+	if b == nil {
+		return nil
+	}
+	return b.Author
+}
+
+// GetCommittedDate returns the CommittedDate field if it's non-nil, zero value otherwise.
+func (b *BlameCommit) GetCommittedDate() Timestamp {
+	if b == nil || b.CommittedDate == nil {
+		return Timestamp{}
+	}
+	return *b.CommittedDate
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (b *BlameCommit) GetSHA() string {
+	if b == nil || b.SHA == nil {
+		return ""
+	}
+	return *b.SHA
+}
+
+// GetAge returns the Age field if it's non-nil, zero value otherwise.
+func (b *BlameRange) GetAge() int {
+	if b == nil || b.Age == nil {
+		return 0
+	}
+	return *b.Age
+}
+
+// GetCommit returns the Commit field.
+func (b *BlameRange) GetCommit() *BlameCommit {
+	if b == nil {
+		return nil
+	}
+	return b.Commit
+}
+
+// GetEndingLine returns the EndingLine field if it's non-nil, zero value otherwise.
+func (b *BlameRange) GetEndingLine() int {
+	if b == nil || b.EndingLine == nil {
+		return 0
+	}
+	return *b.EndingLine
+}
+
+// GetStartingLine returns the StartingLine field if it's non-nil, zero value otherwise.
+func (b *BlameRange) GetStartingLine() int {
+	if b == nil || b.StartingLine == nil {
+		return 0
+	}
+	return *b.StartingLine
+}
+
 // GetContent returns the Content field if it's non-nil, zero value otherwise.
 func (b *Blob) GetContent() string {
 	if b == nil || b.Content == nil {
@@ -6630,6 +6686,30 @@ func (d *DeleteEvent) GetSender() *User {
 	return d.Sender
 }
 
+// GetAuthor returns the Author field.
+func (d *DeleteFilesOptions) GetAuthor() *CommitAuthor {
+	if d == nil {
+		return nil
+	}
+	return d.Author
+}
+
+// GetCommitter returns the Committer field.
+func (d *DeleteFilesOptions) GetCommitter() *CommitAuthor {
+	if d == nil {
+		return nil
+	}
+	return d.Committer
+}
+
+// GetCommit returns the Commit field.
+func (d *DeleteFilesResponse) GetCommit() *Commit {
+	if d == nil {
+		return nil
+	}
+	return d.Commit
+}
+
 // GetAutoDismissedAt returns the AutoDismissedAt field if it's non-nil, zero value otherwise.
 func (d *DependabotAlert) GetAutoDismissedAt() Timestamp {
 	if d == nil || d.AutoDismissedAt == nil {
@@ -6942,6 +7022,110 @@ func (d *Dependency) GetScope() string {
 	return *d.Scope
 }
 
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetChangeType() string {
+	if d == nil || d.ChangeType == nil {
+		return ""
+	}
+	return *d.ChangeType
+}
+
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetEcosystem() string {
+	if d == nil || d.Ecosystem == nil {
+		return ""
+	}
+	return *d.Ecosystem
+}
+
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetLicense() string {
+	if d == nil || d.License == nil {
+		return ""
+	}
+	return *d.License
+}
+
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetManifest() string {
+	if d == nil || d.Manifest == nil {
+		return ""
+	}
+	return *d.Manifest
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
+	}
+	return *d.Name
+}
+
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
+		return ""
+	}
+	return *d.PackageURL
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetScope() string {
+	if d == nil || d.Scope == nil {
+		return ""
+	}
+	return *d.Scope
+}
+
+// GetSourceRepositoryURL returns the SourceRepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetSourceRepositoryURL() string {
+	if d == nil || d.SourceRepositoryURL == nil {
+		return ""
+	}
+	return *d.SourceRepositoryURL
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetVersion() string {
+	if d == nil || d.Version == nil {
+		return ""
+	}
+	return *d.Version
+}
+
+// GetAdvisoryGHSAID returns the AdvisoryGHSAID field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisoryGHSAID() string {
+	if d == nil || d.AdvisoryGHSAID == nil {
+		return ""
+	}
+	return *d.AdvisoryGHSAID
+}
+
+// GetAdvisorySummary returns the AdvisorySummary field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisorySummary() string {
+	if d == nil || d.AdvisorySummary == nil {
+		return ""
+	}
+	return *d.AdvisorySummary
+}
+
+// GetAdvisoryURL returns the AdvisoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisoryURL() string {
+	if d == nil || d.AdvisoryURL == nil {
+		return ""
+	}
+	return *d.AdvisoryURL
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetSeverity() string {
+	if d == nil || d.Severity == nil {
+		return ""
+	}
+	return *d.Severity
+}
+
 // GetLabeledRunners returns the LabeledRunners field if it's non-nil, zero value otherwise.
 func (d *DependencyGraphAutosubmitActionOptions) GetLabeledRunners() bool {
 	if d == nil || d.LabeledRunners == nil {
@@ -23518,6 +23702,54 @@ func (r *RepositoryRulesetUpdatedRules) GetRule() *RepositoryRule {
 	return r.Rule
 }
 
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisory) GetCVEID() string {
+	if r == nil || r.CVEID == nil {
+		return ""
+	}
+	return *r.CVEID
+}
+
+// GetCVSSVector returns the CVSSVector field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisory) GetCVSSVector() string {
+	if r == nil || r.CVSSVector == nil {
+		return ""
+	}
+	return *r.CVSSVector
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisory) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
+	}
+	return *r.Description
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisory) GetSeverity() string {
+	if r == nil || r.Severity == nil {
+		return ""
+	}
+	return *r.Severity
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisory) GetState() string {
+	if r == nil || r.State == nil {
+		return ""
+	}
+	return *r.State
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (r *RepositorySecurityAdvisory) GetSummary() string {
+	if r == nil || r.Summary == nil {
+		return ""
+	}
+	return *r.Summary
+}
+
 // GetCommit returns the Commit field.
 func (r *RepositoryTag) GetCommit() *Commit {
 	if r == nil {
@@ -23550,6 +23782,14 @@ func (r *RepositoryTag) GetZipballURL() string {
 	return *r.ZipballURL
 }
 
+// GetTag returns the Tag field.
+func (r *RepositoryTagWithDate) GetTag() *RepositoryTag {
+	if r == nil {
+		return nil
+	}
+	return r.Tag
+}
+
 // GetAffectedPackageName returns the AffectedPackageName field if it's non-nil, zero value otherwise.
 func (r *RepositoryVulnerabilityAlert) GetAffectedPackageName() string {
 	if r == nil || r.AffectedPackageName == nil {
@@ -23926,6 +24166,14 @@ func (r *RequiredStatusChecksRuleParameters) GetDoNotEnforceOnCreate() bool {
 	return *r.DoNotEnforceOnCreate
 }
 
+// GetTagger returns the Tagger field.
+func (r *ResolvedTag) GetTagger() *CommitAuthor {
+	if r == nil {
+		return nil
+	}
+	return r.Tagger
+}
+
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
 func (r *ReviewersRequest) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
@@ -24302,6 +24550,14 @@ func (s *SARIFUpload) GetProcessingStatus() string {
 	return *s.ProcessingStatus
 }
 
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (s *SarifUploadOptions) GetStartedAt() Timestamp {
+	if s == nil || s.StartedAt == nil {
+		return Timestamp{}
+	}
+	return *s.StartedAt
+}
+
 // GetSBOM returns the SBOM field.
 func (s *SBOM) GetSBOM() *SBOMInfo {
 	if s == nil {
@@ -24982,6 +25238,30 @@ func (s *SecretScanningAlertLocationDetails) GetCommitURL() string {
 	return *s.CommitURL
 }
 
+// GetDiscussionBodyURL returns the DiscussionBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionBodyURL() string {
+	if s == nil || s.DiscussionBodyURL == nil {
+		return ""
+	}
+	return *s.DiscussionBodyURL
+}
+
+// GetDiscussionCommentURL returns the DiscussionCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionCommentURL() string {
+	if s == nil || s.DiscussionCommentURL == nil {
+		return ""
+	}
+	return *s.DiscussionCommentURL
+}
+
+// GetDiscussionTitleURL returns the DiscussionTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionTitleURL() string {
+	if s == nil || s.DiscussionTitleURL == nil {
+		return ""
+	}
+	return *s.DiscussionTitleURL
+}
+
 // GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
 func (s *SecretScanningAlertLocationDetails) GetEndColumn() int {
 	if s == nil || s.EndColumn == nil {
@@ -24998,6 +25278,38 @@ func (s *SecretScanningAlertLocationDetails) GetEndLine() int {
 	return *s.EndLine
 }
 
+// GetIssueBodyURL returns the IssueBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueBodyURL() string {
+	if s == nil || s.IssueBodyURL == nil {
+		return ""
+	}
+	return *s.IssueBodyURL
+}
+
+// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueCommentURL() string {
+	if s == nil || s.IssueCommentURL == nil {
+		return ""
+	}
+	return *s.IssueCommentURL
+}
+
+// GetIssueTitleURL returns the IssueTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueTitleURL() string {
+	if s == nil || s.IssueTitleURL == nil {
+		return ""
+	}
+	return *s.IssueTitleURL
+}
+
+// GetPageURL returns the PageURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPageURL() string {
+	if s == nil || s.PageURL == nil {
+		return ""
+	}
+	return *s.PageURL
+}
+
 // GetPath returns the Path field if it's non-nil, zero value otherwise.
 func (s *SecretScanningAlertLocationDetails) GetPath() string {
 	if s == nil || s.Path == nil {
@@ -25006,6 +25318,14 @@ func (s *SecretScanningAlertLocationDetails) GetPath() string {
 	return *s.Path
 }
 
+// GetPullRequestBodyURL returns the PullRequestBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestBodyURL() string {
+	if s == nil || s.PullRequestBodyURL == nil {
+		return ""
+	}
+	return *s.PullRequestBodyURL
+}
+
 // GetPullRequestCommentURL returns the PullRequestCommentURL field if it's non-nil, zero value otherwise.
 func (s *SecretScanningAlertLocationDetails) GetPullRequestCommentURL() string {
 	if s == nil || s.PullRequestCommentURL == nil {
@@ -25014,6 +25334,30 @@ func (s *SecretScanningAlertLocationDetails) GetPullRequestCommentURL() string {
 	return *s.PullRequestCommentURL
 }
 
+// GetPullRequestReviewCommentURL returns the PullRequestReviewCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestReviewCommentURL() string {
+	if s == nil || s.PullRequestReviewCommentURL == nil {
+		return ""
+	}
+	return *s.PullRequestReviewCommentURL
+}
+
+// GetPullRequestReviewURL returns the PullRequestReviewURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestReviewURL() string {
+	if s == nil || s.PullRequestReviewURL == nil {
+		return ""
+	}
+	return *s.PullRequestReviewURL
+}
+
+// GetPullRequestTitleURL returns the PullRequestTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestTitleURL() string {
+	if s == nil || s.PullRequestTitleURL == nil {
+		return ""
+	}
+	return *s.PullRequestTitleURL
+}
+
 // GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
 func (s *SecretScanningAlertLocationDetails) GetStartColumn() int {
 	if s == nil || s.StartColumn == nil {
@@ -1985,6 +1985,77 @@ func TestAutoTriggerCheck_GetSetting(tt *testing.T) {
 	a.GetSetting()
 }
 
+func TestBlameCommit_GetAuthor(tt *testing.T) {
+	tt.Parallel()
+	b := &BlameCommit{}
+	b.GetAuthor()
+	b = nil
+	b.GetAuthor()
+}
+
+func TestBlameCommit_GetCommittedDate(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	b := &BlameCommit{CommittedDate: &zeroValue}
+	b.GetCommittedDate()
+	b = &BlameCommit{}
+	b.GetCommittedDate()
+	b = nil
+	b.GetCommittedDate()
+}
+
+func TestBlameCommit_GetSHA(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	b := &BlameCommit{SHA: &zeroValue}
+	b.GetSHA()
+	b = &BlameCommit{}
+	b.GetSHA()
+	b = nil
+	b.GetSHA()
+}
+
+func TestBlameRange_GetAge(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	b := &BlameRange{Age: &zeroValue}
+	b.GetAge()
+	b = &BlameRange{}
+	b.GetAge()
+	b = nil
+	b.GetAge()
+}
+
+func TestBlameRange_GetCommit(tt *testing.T) {
+	tt.Parallel()
+	b := &BlameRange{}
+	b.GetCommit()
+	b = nil
+	b.GetCommit()
+}
+
+func TestBlameRange_GetEndingLine(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	b := &BlameRange{EndingLine: &zeroValue}
+	b.GetEndingLine()
+	b = &BlameRange{}
+	b.GetEndingLine()
+	b = nil
+	b.GetEndingLine()
+}
+
+func TestBlameRange_GetStartingLine(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	b := &BlameRange{StartingLine: &zeroValue}
+	b.GetStartingLine()
+	b = &BlameRange{}
+	b.GetStartingLine()
+	b = nil
+	b.GetStartingLine()
+}
+
 func TestBlob_GetContent(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -8632,6 +8703,30 @@ func TestDeleteEvent_GetSender(tt *testing.T) {
 	d.GetSender()
 }
 
+func TestDeleteFilesOptions_GetAuthor(tt *testing.T) {
+	tt.Parallel()
+	d := &DeleteFilesOptions{}
+	d.GetAuthor()
+	d = nil
+	d.GetAuthor()
+}
+
+func TestDeleteFilesOptions_GetCommitter(tt *testing.T) {
+	tt.Parallel()
+	d := &DeleteFilesOptions{}
+	d.GetCommitter()
+	d = nil
+	d.GetCommitter()
+}
+
+func TestDeleteFilesResponse_GetCommit(tt *testing.T) {
+	tt.Parallel()
+	d := &DeleteFilesResponse{}
+	d.GetCommit()
+	d = nil
+	d.GetCommit()
+}
+
 func TestDependabotAlert_GetAutoDismissedAt(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue Timestamp
@@ -9019,6 +9114,149 @@ func TestDependency_GetScope(tt *testing.T) {
 	d.GetScope()
 }
 
+func TestDependencyChange_GetChangeType(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{ChangeType: &zeroValue}
+	d.GetChangeType()
+	d = &DependencyChange{}
+	d.GetChangeType()
+	d = nil
+	d.GetChangeType()
+}
+
+func TestDependencyChange_GetEcosystem(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{Ecosystem: &zeroValue}
+	d.GetEcosystem()
+	d = &DependencyChange{}
+	d.GetEcosystem()
+	d = nil
+	d.GetEcosystem()
+}
+
+func TestDependencyChange_GetLicense(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{License: &zeroValue}
+	d.GetLicense()
+	d = &DependencyChange{}
+	d.GetLicense()
+	d = nil
+	d.GetLicense()
+}
+
+func TestDependencyChange_GetManifest(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{Manifest: &zeroValue}
+	d.GetManifest()
+	d = &DependencyChange{}
+	d.GetManifest()
+	d = nil
+	d.GetManifest()
+}
+
+func TestDependencyChange_GetName(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyChange{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDependencyChange_GetPackageURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyChange{}
+	d.GetPackageURL()
+	d = nil
+	d.GetPackageURL()
+}
+
+func TestDependencyChange_GetScope(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyChange{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
+}
+
+func TestDependencyChange_GetSourceRepositoryURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{SourceRepositoryURL: &zeroValue}
+	d.GetSourceRepositoryURL()
+	d = &DependencyChange{}
+	d.GetSourceRepositoryURL()
+	d = nil
+	d.GetSourceRepositoryURL()
+}
+
+func TestDependencyChange_GetVersion(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChange{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyChange{}
+	d.GetVersion()
+	d = nil
+	d.GetVersion()
+}
+
+func TestDependencyChangeVulnerability_GetAdvisoryGHSAID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChangeVulnerability{AdvisoryGHSAID: &zeroValue}
+	d.GetAdvisoryGHSAID()
+	d = &DependencyChangeVulnerability{}
+	d.GetAdvisoryGHSAID()
+	d = nil
+	d.GetAdvisoryGHSAID()
+}
+
+func TestDependencyChangeVulnerability_GetAdvisorySummary(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChangeVulnerability{AdvisorySummary: &zeroValue}
+	d.GetAdvisorySummary()
+	d = &DependencyChangeVulnerability{}
+	d.GetAdvisorySummary()
+	d = nil
+	d.GetAdvisorySummary()
+}
+
+func TestDependencyChangeVulnerability_GetAdvisoryURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChangeVulnerability{AdvisoryURL: &zeroValue}
+	d.GetAdvisoryURL()
+	d = &DependencyChangeVulnerability{}
+	d.GetAdvisoryURL()
+	d = nil
+	d.GetAdvisoryURL()
+}
+
+func TestDependencyChangeVulnerability_GetSeverity(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DependencyChangeVulnerability{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependencyChangeVulnerability{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
+}
+
 func TestDependencyGraphAutosubmitActionOptions_GetLabeledRunners(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue bool
@@ -30218,6 +30456,72 @@ func TestRepositoryRulesetUpdatedRules_GetRule(tt *testing.T) {
 	r.GetRule()
 }
 
+func TestRepositorySecurityAdvisory_GetCVEID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepositorySecurityAdvisory{CVEID: &zeroValue}
+	r.GetCVEID()
+	r = &RepositorySecurityAdvisory{}
+	r.GetCVEID()
+	r = nil
+	r.GetCVEID()
+}
+
+func TestRepositorySecurityAdvisory_GetCVSSVector(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepositorySecurityAdvisory{CVSSVector: &zeroValue}
+	r.GetCVSSVector()
+	r = &RepositorySecurityAdvisory{}
+	r.GetCVSSVector()
+	r = nil
+	r.GetCVSSVector()
+}
+
+func TestRepositorySecurityAdvisory_GetDescription(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepositorySecurityAdvisory{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepositorySecurityAdvisory{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
+}
+
+func TestRepositorySecurityAdvisory_GetSeverity(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepositorySecurityAdvisory{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &RepositorySecurityAdvisory{}
+	r.GetSeverity()
+	r = nil
+	r.GetSeverity()
+}
+
+func TestRepositorySecurityAdvisory_GetState(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepositorySecurityAdvisory{State: &zeroValue}
+	r.GetState()
+	r = &RepositorySecurityAdvisory{}
+	r.GetState()
+	r = nil
+	r.GetState()
+}
+
+func TestRepositorySecurityAdvisory_GetSummary(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	r := &RepositorySecurityAdvisory{Summary: &zeroValue}
+	r.GetSummary()
+	r = &RepositorySecurityAdvisory{}
+	r.GetSummary()
+	r = nil
+	r.GetSummary()
+}
+
 func TestRepositoryTag_GetCommit(tt *testing.T) {
 	tt.Parallel()
 	r := &RepositoryTag{}
@@ -30259,6 +30563,14 @@ func TestRepositoryTag_GetZipballURL(tt *testing.T) {
 	r.GetZipballURL()
 }
 
+func TestRepositoryTagWithDate_GetTag(tt *testing.T) {
+	tt.Parallel()
+	r := &RepositoryTagWithDate{}
+	r.GetTag()
+	r = nil
+	r.GetTag()
+}
+
 func TestRepositoryVulnerabilityAlert_GetAffectedPackageName(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -30755,6 +31067,14 @@ func TestRequiredStatusChecksRuleParameters_GetDoNotEnforceOnCreate(tt *testing.
 	r.GetDoNotEnforceOnCreate()
 }
 
+func TestResolvedTag_GetTagger(tt *testing.T) {
+	tt.Parallel()
+	r := &ResolvedTag{}
+	r.GetTagger()
+	r = nil
+	r.GetTagger()
+}
+
 func TestReviewersRequest_GetNodeID(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -31272,6 +31592,17 @@ func TestSARIFUpload_GetProcessingStatus(tt *testing.T) {
 	s.GetProcessingStatus()
 }
 
+func TestSarifUploadOptions_GetStartedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	s := &SarifUploadOptions{StartedAt: &zeroValue}
+	s.GetStartedAt()
+	s = &SarifUploadOptions{}
+	s.GetStartedAt()
+	s = nil
+	s.GetStartedAt()
+}
+
 func TestSBOM_GetSBOM(tt *testing.T) {
 	tt.Parallel()
 	s := &SBOM{}
@@ -32159,6 +32490,39 @@ func TestSecretScanningAlertLocationDetails_GetCommitURL(tt *testing.T) {
 	s.GetCommitURL()
 }
 
+func TestSecretScanningAlertLocationDetails_GetDiscussionBodyURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{DiscussionBodyURL: &zeroValue}
+	s.GetDiscussionBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionBodyURL()
+	s = nil
+	s.GetDiscussionBodyURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetDiscussionCommentURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{DiscussionCommentURL: &zeroValue}
+	s.GetDiscussionCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionCommentURL()
+	s = nil
+	s.GetDiscussionCommentURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetDiscussionTitleURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{DiscussionTitleURL: &zeroValue}
+	s.GetDiscussionTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionTitleURL()
+	s = nil
+	s.GetDiscussionTitleURL()
+}
+
 func TestSecretScanningAlertLocationDetails_GetEndColumn(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue int
@@ -32181,6 +32545,50 @@ func TestSecretScanningAlertLocationDetails_GetEndLine(tt *testing.T) {
 	s.GetEndLine()
 }
 
+func TestSecretScanningAlertLocationDetails_GetIssueBodyURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{IssueBodyURL: &zeroValue}
+	s.GetIssueBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueBodyURL()
+	s = nil
+	s.GetIssueBodyURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetIssueCommentURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{IssueCommentURL: &zeroValue}
+	s.GetIssueCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueCommentURL()
+	s = nil
+	s.GetIssueCommentURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetIssueTitleURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{IssueTitleURL: &zeroValue}
+	s.GetIssueTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueTitleURL()
+	s = nil
+	s.GetIssueTitleURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetPageURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PageURL: &zeroValue}
+	s.GetPageURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPageURL()
+	s = nil
+	s.GetPageURL()
+}
+
 func TestSecretScanningAlertLocationDetails_GetPath(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -32192,6 +32600,17 @@ func TestSecretScanningAlertLocationDetails_GetPath(tt *testing.T) {
 	s.GetPath()
 }
 
+func TestSecretScanningAlertLocationDetails_GetPullRequestBodyURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestBodyURL: &zeroValue}
+	s.GetPullRequestBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestBodyURL()
+	s = nil
+	s.GetPullRequestBodyURL()
+}
+
 func TestSecretScanningAlertLocationDetails_GetPullRequestCommentURL(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -32203,6 +32622,39 @@ func TestSecretScanningAlertLocationDetails_GetPullRequestCommentURL(tt *testing
 	s.GetPullRequestCommentURL()
 }
 
+func TestSecretScanningAlertLocationDetails_GetPullRequestReviewCommentURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestReviewCommentURL: &zeroValue}
+	s.GetPullRequestReviewCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestReviewCommentURL()
+	s = nil
+	s.GetPullRequestReviewCommentURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetPullRequestReviewURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestReviewURL: &zeroValue}
+	s.GetPullRequestReviewURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestReviewURL()
+	s = nil
+	s.GetPullRequestReviewURL()
+}
+
+func TestSecretScanningAlertLocationDetails_GetPullRequestTitleURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestTitleURL: &zeroValue}
+	s.GetPullRequestTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestTitleURL()
+	s = nil
+	s.GetPullRequestTitleURL()
+}
+
 func TestSecretScanningAlertLocationDetails_GetStartColumn(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue int
@@ -54,6 +54,7 @@ const (
 	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 	mediaTypeIssueImportAPI    = "application/vnd.github.golden-comet-preview+json"
+	mediaTypeSCIM              = "application/scim+json"
 
 	// Media Type values to access preview APIs
 	// These media types will be added to the API request as headers
@@ -184,6 +185,17 @@ type Client struct {
 	// Whether to respect rate limit headers on endpoints that return 302 redirections to artifacts
 	RateLimitRedirectionalEndpoints bool
 
+	// pollDelay is the initial delay between attempts of methods that poll
+	// GitHub until a result is ready. Zero means defaultPollDelay.
+	pollDelay time.Duration
+
+	// requestTimeout bounds each call to Do whose context has no deadline.
+	// Zero means no timeout. Set with WithRequestTimeout.
+	requestTimeout time.Duration
+
+	emojiMu sync.Mutex
+	emojis  map[string]string // Emojis cached by EmojisService.ListCached.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
@@ -400,6 +412,17 @@ func (c *Client) WithEnterpriseURLs(baseURL, uploadURL string) (*Client, error)
 	return c2, nil
 }
 
+// WithRequestTimeout returns a copy of the client that applies a timeout of d
+// to every API call made through Do whose context has no deadline. A deadline
+// set on the caller's context always wins, even if it is later than d. A
+// zero or negative d disables the timeout.
+func (c *Client) WithRequestTimeout(d time.Duration) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.requestTimeout = d
+	return c2
+}
+
 // initialize sets default values and initializes services.
 func (c *Client) initialize() {
 	if c.client == nil {
@@ -473,6 +496,7 @@ func (c *Client) copy() *Client {
 		UploadURL:                       c.UploadURL,
 		RateLimitRedirectionalEndpoints: c.RateLimitRedirectionalEndpoints,
 		secondaryRateLimitReset:         c.secondaryRateLimitReset,
+		requestTimeout:                  c.requestTimeout,
 	}
 	c.clientMu.Unlock()
 	if c.client != nil {
@@ -677,6 +701,10 @@ type Response struct {
 	// propagate to Response.
 	Rate Rate
 
+	// RateCategory is the rate limit category the request was counted
+	// against, as determined by Client.RateLimitCategory.
+	RateCategory RateLimitCategory
+
 	// token's expiration date. Timestamp is 0001-01-01 when token doesn't expire.
 	// So it is valid for TokenExpiration.Equal(Timestamp{}) or TokenExpiration.Time.After(time.Now())
 	TokenExpiration Timestamp
@@ -850,20 +878,22 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 
 	req = withContext(ctx, req)
 
-	rateLimitCategory := GetRateLimitCategory(req.Method, req.URL.Path)
+	rateLimitCategory := c.RateLimitCategory(req)
 
 	if bypass := ctx.Value(BypassRateLimitCheck); bypass == nil {
 		// If we've hit rate limit, don't make further requests before Reset time.
 		if err := c.checkRateLimitBeforeDo(req, rateLimitCategory); err != nil {
 			return &Response{
-				Response: err.Response,
-				Rate:     err.Rate,
+				Response:     err.Response,
+				Rate:         err.Rate,
+				RateCategory: rateLimitCategory,
 			}, err
 		}
 		// If we've hit a secondary rate limit, don't make further requests before Retry After.
 		if err := c.checkSecondaryRateLimitBeforeDo(req); err != nil {
 			return &Response{
-				Response: err.Response,
+				Response:     err.Response,
+				RateCategory: rateLimitCategory,
 			}, err
 		}
 	}
@@ -872,6 +902,7 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 	var response *Response
 	if resp != nil {
 		response = newResponse(resp)
+		response.RateCategory = rateLimitCategory
 	}
 
 	if err != nil {
@@ -1020,8 +1051,17 @@ func (c *Client) bareDoUntilFound(ctx context.Context, req *http.Request, maxRed
 // *RateLimitError immediately without making a network API call.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it
-// is canceled or times out, ctx.Err() will be returned.
+// is canceled or times out, ctx.Err() will be returned. If ctx has no deadline
+// and the client was configured with WithRequestTimeout, the timeout applies.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if c.requestTimeout > 0 && ctx != nil {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+			defer cancel()
+		}
+	}
+
 	resp, err := c.BareDo(ctx, req)
 	if err != nil {
 		return resp, err
@@ -1126,6 +1166,29 @@ func compareHTTPResponse(r1, r2 *http.Response) bool {
 	return false
 }
 
+// Sentinel errors matching the status code of an *ErrorResponse. They can be
+// tested with errors.Is, while errors.As still gives access to the
+// *ErrorResponse:
+//
+//	if errors.Is(err, github.ErrNotFound) {
+//		// handle missing resource
+//	}
+var (
+	ErrUnauthorized = errors.New("unauthorized")      // 401 Unauthorized
+	ErrForbidden    = errors.New("forbidden")         // 403 Forbidden
+	ErrNotFound     = errors.New("not found")         // 404 Not Found
+	ErrConflict     = errors.New("conflict")          // 409 Conflict
+	ErrValidation   = errors.New("validation failed") // 422 Unprocessable Entity
+)
+
+var statusErrors = map[int]error{
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusConflict:            ErrConflict,
+	http.StatusUnprocessableEntity: ErrValidation,
+}
+
 /*
 An ErrorResponse reports one or more errors caused by an API request.
 
@@ -1166,8 +1229,16 @@ func (r *ErrorResponse) Error() string {
 	return fmt.Sprintf("%v %+v", r.Message, r.Errors)
 }
 
-// Is returns whether the provided error equals this error.
+// Is returns whether the provided error equals this error, or is the
+// sentinel error matching the status code of the response, such as
+// ErrNotFound for a 404 response.
 func (r *ErrorResponse) Is(target error) bool {
+	if r.Response != nil {
+		if sentinel, ok := statusErrors[r.Response.StatusCode]; ok && target == sentinel {
+			return true
+		}
+	}
+
 	v, ok := target.(*ErrorResponse)
 	if !ok {
 		return false
@@ -1217,6 +1288,9 @@ type TwoFactorAuthError ErrorResponse
 
 func (r *TwoFactorAuthError) Error() string { return (*ErrorResponse)(r).Error() }
 
+// Is reports whether target is ErrUnauthorized.
+func (r *TwoFactorAuthError) Is(target error) bool { return target == ErrUnauthorized }
+
 // RateLimitError occurs when GitHub returns 403 Forbidden response with a rate limit
 // remaining value of 0.
 type RateLimitError struct {
@@ -1548,6 +1622,12 @@ func GetRateLimitCategory(method, path string) RateLimitCategory {
 	}
 }
 
+// RateLimitCategory returns the rate limit RateLimitCategory that req is
+// counted against. It is the category reported in Response.RateCategory.
+func (c *Client) RateLimitCategory(req *http.Request) RateLimitCategory {
+	return GetRateLimitCategory(req.Method, req.URL.Path)
+}
+
 // RateLimits returns the rate limits for the current client.
 //
 // Deprecated: Use RateLimitService.Get instead.
@@ -1706,6 +1786,35 @@ func sleepUntilResetWithBuffer(ctx context.Context, reset time.Time) error {
 	return nil
 }
 
+const (
+	defaultPollDelay = time.Second
+	maxPollDelay     = 30 * time.Second
+	maxPollAttempts  = 10
+)
+
+// sleepBeforePoll waits before retrying the given zero-based polling attempt.
+// The delay starts at the client's poll delay and doubles with every attempt,
+// up to maxPollDelay.
+func (c *Client) sleepBeforePoll(ctx context.Context, attempt int) error {
+	delay := c.pollDelay
+	if delay <= 0 {
+		delay = defaultPollDelay
+	}
+	for i := 0; i < attempt && delay < maxPollDelay; i++ {
+		delay *= 2
+	}
+	timer := time.NewTimer(min(delay, maxPollDelay))
+	select {
+	case <-ctx.Done():
+		if !timer.Stop() {
+			<-timer.C
+		}
+		return ctx.Err()
+	case <-timer.C:
+	}
+	return nil
+}
+
 // When using roundTripWithOptionalFollowRedirect, note that it
 // is the responsibility of the caller to close the response body.
 func (c *Client) roundTripWithOptionalFollowRedirect(ctx context.Context, u string, maxRedirects int, opts ...RequestOption) (*http.Response, error) {
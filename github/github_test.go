@@ -1082,6 +1082,56 @@ func TestDo_nilContext(t *testing.T) {
 	}
 }
 
+func TestDo_requestTimeout(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client = client.WithRequestTimeout(20 * time.Millisecond)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	start := time.Now()
+	_, err := client.Do(context.Background(), req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Do took %v, want it to be cancelled after the request timeout", elapsed)
+	}
+}
+
+func TestDo_requestTimeoutCallerDeadlineWins(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client = client.WithRequestTimeout(time.Nanosecond)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	type foo struct {
+		A string
+	}
+	req, _ := client.NewRequest("GET", ".", nil)
+	body := new(foo)
+	_, err := client.Do(ctx, req, body)
+	assertNilError(t, err)
+
+	want := &foo{"a"}
+	if !cmp.Equal(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+}
+
 func TestDo_httpError(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -1307,6 +1357,53 @@ func TestDo_rateLimitCategory(t *testing.T) {
 	}
 }
 
+func TestClient_RateLimitCategory(t *testing.T) {
+	t.Parallel()
+	client := NewClient(nil)
+
+	tests := []struct {
+		method   string
+		url      string
+		category RateLimitCategory
+	}{
+		{http.MethodGet, "repos/o/r", CoreCategory},
+		{http.MethodGet, "search/repositories?q=go", SearchCategory},
+		{http.MethodGet, "search/code?q=go", CodeSearchCategory},
+		{http.MethodPost, "graphql", GraphqlCategory},
+		{http.MethodPost, "repos/o/r/code-scanning/sarifs", CodeScanningUploadCategory},
+		{http.MethodGet, "enterprises/e/audit-log", AuditLogCategory},
+	}
+
+	for _, tt := range tests {
+		req, err := client.NewRequest(tt.method, tt.url, nil)
+		if err != nil {
+			t.Fatalf("NewRequest(%v, %v) returned error: %v", tt.method, tt.url, err)
+		}
+		if got, want := client.RateLimitCategory(req), tt.category; got != want {
+			t.Errorf("RateLimitCategory(%v %v) = %v, want %v", tt.method, tt.url, got, want)
+		}
+	}
+}
+
+func TestDo_rateCategory(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest("GET", "search/issues?q=rate", nil)
+	ctx := context.Background()
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if got, want := resp.RateCategory, client.RateLimitCategory(req); got != want {
+		t.Errorf("Response.RateCategory = %v, want %v", got, want)
+	}
+}
+
 // Ensure rate limit is still parsed, even for error responses.
 func TestDo_rateLimit_errorResponse(t *testing.T) {
 	t.Parallel()
@@ -2021,6 +2118,72 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_statusSentinels(t *testing.T) {
+	t.Parallel()
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrValidation}
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusBadRequest, nil},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusInternalServerError, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			t.Parallel()
+			res := &http.Response{
+				Request:    &http.Request{},
+				StatusCode: tc.status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"message":"m"}`)),
+			}
+			err := CheckResponse(res)
+
+			for _, sentinel := range sentinels {
+				if got, want := errors.Is(err, sentinel), sentinel == tc.want; got != want {
+					t.Errorf("errors.Is(err, %q) = %v, want %v", sentinel, got, want)
+				}
+			}
+
+			var errResp *ErrorResponse
+			if !errors.As(err, &errResp) {
+				t.Fatalf("errors.As(err, *ErrorResponse) = false, want true")
+			}
+			if errResp.Message != "m" {
+				t.Errorf("ErrorResponse.Message = %q, want %q", errResp.Message, "m")
+			}
+		})
+	}
+}
+
+func TestCheckResponse_twoFactorUnauthorized(t *testing.T) {
+	t.Parallel()
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusUnauthorized,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"message":"m"}`)),
+	}
+	res.Header.Set(headerOTP, "required; sms")
+
+	err := CheckResponse(res)
+	if _, ok := err.(*TwoFactorAuthError); !ok {
+		t.Fatalf("CheckResponse returned %T, want *TwoFactorAuthError", err)
+	}
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Error("errors.Is(err, ErrUnauthorized) = false, want true")
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Error("errors.Is(err, ErrNotFound) = true, want false")
+	}
+}
+
 func TestCheckResponse_RateLimit(t *testing.T) {
 	t.Parallel()
 	res := &http.Response{
@@ -0,0 +1,74 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// graphQLRequest represents a request to the GitHub GraphQL API.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents a response from the GitHub GraphQL API.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQLURL returns the URL of the GraphQL API endpoint matching c.BaseURL.
+// GitHub Enterprise Server serves it at /api/graphql rather than below the
+// /api/v3/ REST prefix.
+func (c *Client) graphQLURL() string {
+	u := *c.BaseURL
+	if strings.HasSuffix(u.Path, "/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/")
+	}
+	u.Path += "graphql"
+	u.RawPath = ""
+	return u.String()
+}
+
+// doGraphQL sends query with variables to the GraphQL API and decodes the
+// "data" member of the response into v. Errors reported by the GraphQL API
+// are returned as an error.
+//
+// It is used for the few operations that have no REST equivalent.
+func (c *Client) doGraphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*Response, error) {
+	req, err := c.NewRequest("POST", c.graphQLURL(), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+
+	gr := new(graphQLResponse)
+	resp, err := c.Do(ctx, req, gr)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(gr.Errors) > 0 {
+		msgs := make([]string, len(gr.Errors))
+		for i, e := range gr.Errors {
+			msgs[i] = e.Message
+		}
+		return resp, errors.New("graphql: " + strings.Join(msgs, "; "))
+	}
+
+	if v != nil && len(gr.Data) > 0 {
+		if err := json.Unmarshal(gr.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
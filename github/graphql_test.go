@@ -0,0 +1,78 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestClient_graphQLURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "https://api.github.com/", want: "https://api.github.com/graphql"},
+		{baseURL: "https://ghe.example.com/api/v3/", want: "https://ghe.example.com/api/graphql"},
+		{baseURL: "https://example.com/api-v3/", want: "https://example.com/api-v3/graphql"},
+	}
+
+	for _, tt := range tests {
+		client := NewClient(nil)
+		baseURL, err := url.Parse(tt.baseURL)
+		if err != nil {
+			t.Fatalf("url.Parse(%v) returned error: %v", tt.baseURL, err)
+		}
+		client.BaseURL = baseURL
+		if got := client.graphQLURL(); got != tt.want {
+			t.Errorf("graphQLURL() for %v = %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestClient_doGraphQL(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"query($n: Int!) { n }","variables":{"n":1}}`+"\n")
+		fmt.Fprint(w, `{"data":{"n":1}}`)
+	})
+
+	var data struct {
+		N int `json:"n"`
+	}
+	ctx := context.Background()
+	if _, err := client.doGraphQL(ctx, "query($n: Int!) { n }", map[string]interface{}{"n": 1}, &data); err != nil {
+		t.Fatalf("doGraphQL returned error: %v", err)
+	}
+	if data.N != 1 {
+		t.Errorf("doGraphQL decoded n = %v, want 1", data.N)
+	}
+}
+
+func TestClient_doGraphQL_errors(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null,"errors":[{"message":"a"},{"message":"b"}]}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.doGraphQL(ctx, "query { n }", nil, nil)
+	if err == nil {
+		t.Fatal("doGraphQL returned nil error, want error")
+	}
+	if got, want := err.Error(), "graphql: a; b"; got != want {
+		t.Errorf("doGraphQL returned error %q, want %q", got, want)
+	}
+}
@@ -11,11 +11,20 @@ package github
 // GitHub API docs: https://docs.github.com/rest/interactions/
 type InteractionsService service
 
+// Possible values of InteractionRestriction.Limit, which can also be passed
+// as the limit argument of the InteractionsService update methods.
+const (
+	InteractionLimitExistingUsers     = "existing_users"
+	InteractionLimitContributorsOnly  = "contributors_only"
+	InteractionLimitCollaboratorsOnly = "collaborators_only"
+)
+
 // InteractionRestriction represents the interaction restrictions for repository and organization.
 type InteractionRestriction struct {
 	// Specifies the group of GitHub users who can
 	// comment, open issues, or create pull requests for the given repository.
-	// Possible values are: "existing_users", "contributors_only" and "collaborators_only".
+	// Possible values are: InteractionLimitExistingUsers,
+	// InteractionLimitContributorsOnly and InteractionLimitCollaboratorsOnly.
 	Limit *string `json:"limit,omitempty"`
 
 	// Origin specifies the type of the resource to interact with.
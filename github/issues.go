@@ -356,6 +356,26 @@ func (s *IssuesService) RemoveMilestone(ctx context.Context, owner, repo string,
 	return i, resp, nil
 }
 
+// LockReason is the reason an issue or pull request conversation is locked.
+type LockReason string
+
+// Possible values of LockReason.
+const (
+	LockReasonOffTopic  LockReason = "off-topic"
+	LockReasonTooHeated LockReason = "too heated"
+	LockReasonResolved  LockReason = "resolved"
+	LockReasonSpam      LockReason = "spam"
+)
+
+// validate returns an error if r is not one of the known lock reasons.
+func (r LockReason) validate() error {
+	switch r {
+	case LockReasonOffTopic, LockReasonTooHeated, LockReasonResolved, LockReasonSpam:
+		return nil
+	}
+	return fmt.Errorf("invalid lock reason %q", r)
+}
+
 // LockIssueOptions specifies the optional parameters to the
 // IssuesService.Lock method.
 type LockIssueOptions struct {
@@ -371,6 +391,12 @@ type LockIssueOptions struct {
 //
 //meta:operation PUT /repos/{owner}/{repo}/issues/{issue_number}/lock
 func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, number int, opts *LockIssueOptions) (*Response, error) {
+	if opts != nil && opts.LockReason != "" {
+		if err := LockReason(opts.LockReason).validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", owner, repo, number)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
@@ -394,3 +420,37 @@ func (s *IssuesService) Unlock(ctx context.Context, owner string, repo string, n
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// Relock locks an issue's conversation with the given reason. GitHub ignores
+// the reason when locking an already locked issue, so if the issue is locked
+// with a different reason it is unlocked and locked again. If it is already
+// locked with reason, nothing is changed.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#get-an-issue
+// GitHub API docs: https://docs.github.com/rest/issues/issues#lock-an-issue
+// GitHub API docs: https://docs.github.com/rest/issues/issues#unlock-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/lock
+//meta:operation PUT /repos/{owner}/{repo}/issues/{issue_number}/lock
+func (s *IssuesService) Relock(ctx context.Context, owner, repo string, number int, reason LockReason) (*Response, error) {
+	if err := reason.validate(); err != nil {
+		return nil, err
+	}
+
+	issue, resp, err := s.Get(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	if issue.GetLocked() {
+		if LockReason(issue.GetActiveLockReason()) == reason {
+			return resp, nil
+		}
+		if resp, err := s.Unlock(ctx, owner, repo, number); err != nil {
+			return resp, err
+		}
+	}
+
+	return s.Lock(ctx, owner, repo, number, &LockIssueOptions{LockReason: string(reason)})
+}
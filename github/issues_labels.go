@@ -7,7 +7,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // Label represents a GitHub label on an Issue.
@@ -175,6 +178,55 @@ func (s *IssuesService) AddLabelsToIssue(ctx context.Context, owner string, repo
 	return l, resp, nil
 }
 
+// AddLabelsEnsuringExist adds labels to an issue, first creating any of them
+// that do not exist in the repository yet, using their Color and Description.
+// A label created concurrently by someone else is treated as existing.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#add-labels-to-an-issue
+// GitHub API docs: https://docs.github.com/rest/issues/labels#create-a-label
+// GitHub API docs: https://docs.github.com/rest/issues/labels#get-a-label
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/labels
+//meta:operation POST /repos/{owner}/{repo}/labels
+//meta:operation GET /repos/{owner}/{repo}/labels/{name}
+func (s *IssuesService) AddLabelsEnsuringExist(ctx context.Context, owner, repo string, number int, labels []*Label) ([]*Label, *Response, error) {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if label.GetName() == "" {
+			return nil, nil, errors.New("label name must be provided")
+		}
+
+		_, resp, err := s.GetLabel(ctx, owner, repo, label.GetName())
+		if err != nil {
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return nil, resp, err
+			}
+			_, resp, err = s.CreateLabel(ctx, owner, repo, label)
+			if err != nil && !isAlreadyExists(err) {
+				return nil, resp, err
+			}
+		}
+		names = append(names, label.GetName())
+	}
+
+	return s.AddLabelsToIssue(ctx, owner, repo, number, names)
+}
+
+// isAlreadyExists reports whether err is a validation error caused by the
+// resource already existing.
+func isAlreadyExists(err error) bool {
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		return false
+	}
+	for _, e := range errorResponse.Errors {
+		if e.Code == "already_exists" {
+			return true
+		}
+	}
+	return false
+}
+
 // RemoveLabelForIssue removes a label for an issue.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/labels#remove-a-label-from-an-issue
@@ -190,6 +242,33 @@ func (s *IssuesService) RemoveLabelForIssue(ctx context.Context, owner string, r
 	return s.client.Do(ctx, req, nil)
 }
 
+// RemoveLabelIfPresent removes a label from an issue, reporting whether it
+// was removed. Unlike RemoveLabelForIssue, it does not return an error if the
+// label is not on the issue.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#remove-a-label-from-an-issue
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/labels/{name}
+func (s *IssuesService) RemoveLabelIfPresent(ctx context.Context, owner, repo string, number int, label string) (bool, *Response, error) {
+	resp, err := s.RemoveLabelForIssue(ctx, owner, repo, number, label)
+	if err != nil {
+		if isLabelNotPresent(err) {
+			return false, resp, nil
+		}
+		return false, resp, err
+	}
+	return true, resp, nil
+}
+
+// isLabelNotPresent reports whether err is the 404 returned when removing a
+// label that is not on the issue, as opposed to a missing issue or repository.
+func isLabelNotPresent(err error) bool {
+	var errorResponse *ErrorResponse
+	return errors.As(err, &errorResponse) &&
+		errorResponse.Response.StatusCode == http.StatusNotFound &&
+		strings.Contains(errorResponse.Message, "Label does not exist")
+}
+
 // ReplaceLabelsForIssue replaces all labels for an issue.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/labels#set-labels-for-an-issue
@@ -211,6 +290,73 @@ func (s *IssuesService) ReplaceLabelsForIssue(ctx context.Context, owner string,
 	return l, resp, nil
 }
 
+// SetLabelsMinimal sets the labels of an issue to desired, like
+// ReplaceLabelsForIssue, but only adds the missing labels and removes the
+// extra ones, so that unchanged labels produce no label events or
+// notifications. No changes are made if the labels already match. Label names
+// are compared case-insensitively. It returns the resulting labels.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#add-labels-to-an-issue
+// GitHub API docs: https://docs.github.com/rest/issues/labels#list-labels-for-an-issue
+// GitHub API docs: https://docs.github.com/rest/issues/labels#remove-a-label-from-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/labels
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/labels
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/labels/{name}
+func (s *IssuesService) SetLabelsMinimal(ctx context.Context, owner, repo string, number int, desired []string) ([]*Label, *Response, error) {
+	var (
+		current []*Label
+		resp    *Response
+	)
+	opts := &ListOptions{PerPage: 100}
+	for {
+		labels, r, err := s.ListLabelsByIssue(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, r, err
+		}
+		resp = r
+		current = append(current, labels...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	want := make(map[string]bool, len(desired))
+	for _, name := range desired {
+		want[strings.ToLower(name)] = true
+	}
+	have := make(map[string]bool, len(current))
+	var kept []*Label
+	for _, label := range current {
+		key := strings.ToLower(label.GetName())
+		have[key] = true
+		if want[key] {
+			kept = append(kept, label)
+			continue
+		}
+		if _, r, err := s.RemoveLabelIfPresent(ctx, owner, repo, number, label.GetName()); err != nil {
+			return nil, r, err
+		}
+	}
+
+	var add []string
+	for _, name := range desired {
+		key := strings.ToLower(name)
+		if have[key] {
+			continue
+		}
+		have[key] = true
+		add = append(add, name)
+	}
+	if len(add) == 0 {
+		return kept, resp, nil
+	}
+
+	return s.AddLabelsToIssue(ctx, owner, repo, number, add)
+}
+
 // RemoveLabelsForIssue removes all labels for an issue.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/labels#remove-all-labels-from-an-issue
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -347,6 +348,122 @@ func TestIssuesService_AddLabelsToIssue_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestIssuesService_AddLabelsEnsuringExist(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/labels/bug", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"bug"}`)
+	})
+	mux.HandleFunc("/repos/o/r/labels/new", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	var created bool
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"new","color":"f29513","description":"d"}`+"\n")
+		created = true
+		fmt.Fprint(w, `{"name":"new"}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if !created {
+			t.Error("labels were added to the issue before the missing label was created")
+		}
+		testBody(t, r, `["bug","new"]`+"\n")
+		fmt.Fprint(w, `[{"name":"bug"},{"name":"new"}]`)
+	})
+
+	labels := []*Label{
+		{Name: Ptr("bug")},
+		{Name: Ptr("new"), Color: Ptr("f29513"), Description: Ptr("d")},
+	}
+	ctx := context.Background()
+	got, _, err := client.Issues.AddLabelsEnsuringExist(ctx, "o", "r", 1, labels)
+	if err != nil {
+		t.Errorf("Issues.AddLabelsEnsuringExist returned error: %v", err)
+	}
+
+	want := []*Label{{Name: Ptr("bug")}, {Name: Ptr("new")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Issues.AddLabelsEnsuringExist returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddLabelsEnsuringExist"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.AddLabelsEnsuringExist(ctx, "\n", "\n", -1, labels)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.AddLabelsEnsuringExist(ctx, "o", "r", 1, labels)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_AddLabelsEnsuringExist_alreadyExists(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/labels/new", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Label","code":"already_exists","field":"name"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `["new"]`+"\n")
+		fmt.Fprint(w, `[{"name":"new"}]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Issues.AddLabelsEnsuringExist(ctx, "o", "r", 1, []*Label{{Name: Ptr("new")}})
+	if err != nil {
+		t.Errorf("Issues.AddLabelsEnsuringExist returned error: %v", err)
+	}
+
+	want := []*Label{{Name: Ptr("new")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Issues.AddLabelsEnsuringExist returned %+v, want %+v", got, want)
+	}
+}
+
+func TestIssuesService_AddLabelsEnsuringExist_createError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/labels/new", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"Label","code":"invalid","field":"color"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("labels were added to the issue after creating a label failed")
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Issues.AddLabelsEnsuringExist(ctx, "o", "r", 1, []*Label{{Name: Ptr("new"), Color: Ptr("zz")}})
+	if err == nil {
+		t.Error("Issues.AddLabelsEnsuringExist returned nil error, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Issues.AddLabelsEnsuringExist returned response %+v, want status %v", resp, http.StatusUnprocessableEntity)
+	}
+}
+
 func TestIssuesService_RemoveLabelForIssue(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -381,6 +498,52 @@ func TestIssuesService_RemoveLabelForIssue_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestIssuesService_RemoveLabelIfPresent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "present", status: http.StatusOK, body: `[]`, wantRemoved: true},
+		{name: "absent", status: http.StatusNotFound, body: `{"message":"Label does not exist"}`},
+		{name: "issue not found", status: http.StatusNotFound, body: `{"message":"Not Found"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			mux.HandleFunc("/repos/o/r/issues/1/labels/l", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			ctx := context.Background()
+			removed, _, err := client.Issues.RemoveLabelIfPresent(ctx, "o", "r", 1, "l")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Issues.RemoveLabelIfPresent returned error %v, want error: %v", err, tt.wantErr)
+			}
+			if removed != tt.wantRemoved {
+				t.Errorf("Issues.RemoveLabelIfPresent returned %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestIssuesService_RemoveLabelIfPresent_invalidOwner(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Issues.RemoveLabelIfPresent(ctx, "%", "%", 1, "%")
+	testURLParseError(t, err)
+}
+
 func TestIssuesService_ReplaceLabelsForIssue(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -434,6 +597,74 @@ func TestIssuesService_ReplaceLabelsForIssue_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestIssuesService_SetLabelsMinimal(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var removed []string
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"per_page": "100"})
+			fmt.Fprint(w, `[{"name":"bug"},{"name":"stale"},{"name":"P1"}]`)
+		case "POST":
+			testBody(t, r, `["triaged"]`+"\n")
+			fmt.Fprint(w, `[{"name":"bug"},{"name":"P1"},{"name":"triaged"}]`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/labels/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		removed = append(removed, strings.TrimPrefix(r.URL.Path, "/repos/o/r/issues/1/labels/"))
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Issues.SetLabelsMinimal(ctx, "o", "r", 1, []string{"bug", "p1", "triaged"})
+	if err != nil {
+		t.Fatalf("Issues.SetLabelsMinimal returned error: %v", err)
+	}
+
+	want := []*Label{{Name: Ptr("bug")}, {Name: Ptr("P1")}, {Name: Ptr("triaged")}}
+	if !cmp.Equal(labels, want) {
+		t.Errorf("Issues.SetLabelsMinimal returned %+v, want %+v", labels, want)
+	}
+	if !cmp.Equal(removed, []string{"stale"}) {
+		t.Errorf("Issues.SetLabelsMinimal removed %v, want [stale]", removed)
+	}
+
+	const methodName = "SetLabelsMinimal"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.SetLabelsMinimal(ctx, "\n", "\n", -1, nil)
+		return err
+	})
+}
+
+func TestIssuesService_SetLabelsMinimal_unchanged(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"bug"},{"name":"P1"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/labels/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %v %v", r.Method, r.URL.Path)
+	})
+
+	ctx := context.Background()
+	labels, _, err := client.Issues.SetLabelsMinimal(ctx, "o", "r", 1, []string{"P1", "bug"})
+	if err != nil {
+		t.Fatalf("Issues.SetLabelsMinimal returned error: %v", err)
+	}
+
+	want := []*Label{{Name: Ptr("bug")}, {Name: Ptr("P1")}}
+	if !cmp.Equal(labels, want) {
+		t.Errorf("Issues.SetLabelsMinimal returned %+v, want %+v", labels, want)
+	}
+}
+
 func TestIssuesService_RemoveLabelsForIssue(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
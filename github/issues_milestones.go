@@ -7,9 +7,14 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// ErrMilestoneNotFound is returned by GetMilestoneByTitle when no milestone
+// with the requested title exists.
+var ErrMilestoneNotFound = errors.New("milestone not found")
+
 // Milestone represents a GitHub repository milestone.
 type Milestone struct {
 	URL          *string    `json:"url,omitempty"`
@@ -99,6 +104,56 @@ func (s *IssuesService) GetMilestone(ctx context.Context, owner string, repo str
 	return milestone, resp, nil
 }
 
+// GetMilestoneByTitle gets the milestone with the given title, paging through
+// the repository's milestones filtered by state (open, closed or all).
+// It returns ErrMilestoneNotFound if there is no such milestone.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/milestones#list-milestones
+//
+//meta:operation GET /repos/{owner}/{repo}/milestones
+func (s *IssuesService) GetMilestoneByTitle(ctx context.Context, owner, repo, title, state string) (*Milestone, *Response, error) {
+	opts := &MilestoneListOptions{
+		State:       state,
+		ListOptions: ListOptions{PerPage: 100},
+	}
+	for {
+		milestones, resp, err := s.ListMilestones(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, m := range milestones {
+			if m.GetTitle() == title {
+				return m, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, ErrMilestoneNotFound
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// EnsureMilestone returns the milestone titled milestone.Title, in any state,
+// creating it from milestone if it does not exist yet.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/milestones#create-a-milestone
+// GitHub API docs: https://docs.github.com/rest/issues/milestones#list-milestones
+//
+//meta:operation GET /repos/{owner}/{repo}/milestones
+//meta:operation POST /repos/{owner}/{repo}/milestones
+func (s *IssuesService) EnsureMilestone(ctx context.Context, owner, repo string, milestone *Milestone) (*Milestone, *Response, error) {
+	if milestone.GetTitle() == "" {
+		return nil, nil, errors.New("milestone title must be provided")
+	}
+
+	m, resp, err := s.GetMilestoneByTitle(ctx, owner, repo, milestone.GetTitle(), "all")
+	if !errors.Is(err, ErrMilestoneNotFound) {
+		return m, resp, err
+	}
+
+	return s.CreateMilestone(ctx, owner, repo, milestone)
+}
+
 // CreateMilestone creates a new milestone on the specified repository.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/milestones#create-a-milestone
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -110,6 +111,104 @@ func TestIssuesService_GetMilestone_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestIssuesService_GetMilestoneByTitle(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/milestones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"state": "closed", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/milestones?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1,"title":"v1"}]`)
+		case "2":
+			testFormValues(t, r, values{"state": "closed", "per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[{"number":2,"title":"v2"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	milestone, _, err := client.Issues.GetMilestoneByTitle(ctx, "o", "r", "v2", "closed")
+	if err != nil {
+		t.Errorf("Issues.GetMilestoneByTitle returned error: %v", err)
+	}
+
+	want := &Milestone{Number: Ptr(2), Title: Ptr("v2")}
+	if !cmp.Equal(milestone, want) {
+		t.Errorf("Issues.GetMilestoneByTitle returned %+v, want %+v", milestone, want)
+	}
+
+	milestone, _, err = client.Issues.GetMilestoneByTitle(ctx, "o", "r", "v3", "closed")
+	if !errors.Is(err, ErrMilestoneNotFound) {
+		t.Errorf("Issues.GetMilestoneByTitle returned error %v, want %v", err, ErrMilestoneNotFound)
+	}
+	if milestone != nil {
+		t.Errorf("Issues.GetMilestoneByTitle returned %+v, want nil", milestone)
+	}
+
+	const methodName = "GetMilestoneByTitle"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Issues.GetMilestoneByTitle(ctx, "\n", "\n", "v2", "closed")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Issues.GetMilestoneByTitle(ctx, "o", "r", "v2", "closed")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestIssuesService_EnsureMilestone(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var created bool
+	mux.HandleFunc("/repos/o/r/milestones", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"state": "all", "per_page": "100"})
+			if created {
+				fmt.Fprint(w, `[{"number":1,"title":"v1"}]`)
+			} else {
+				fmt.Fprint(w, `[]`)
+			}
+		case "POST":
+			testBody(t, r, `{"title":"v1","description":"d"}`+"\n")
+			if created {
+				t.Error("Issues.EnsureMilestone created an existing milestone again")
+			}
+			created = true
+			fmt.Fprint(w, `{"number":1,"title":"v1"}`)
+		default:
+			t.Errorf("unexpected request method %v", r.Method)
+		}
+	})
+
+	input := &Milestone{Title: Ptr("v1"), Description: Ptr("d")}
+	want := &Milestone{Number: Ptr(1), Title: Ptr("v1")}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		milestone, _, err := client.Issues.EnsureMilestone(ctx, "o", "r", input)
+		if err != nil {
+			t.Errorf("Issues.EnsureMilestone returned error: %v", err)
+		}
+		if !cmp.Equal(milestone, want) {
+			t.Errorf("Issues.EnsureMilestone returned %+v, want %+v", milestone, want)
+		}
+	}
+	if !created {
+		t.Error("Issues.EnsureMilestone did not create the missing milestone")
+	}
+
+	if _, _, err := client.Issues.EnsureMilestone(ctx, "o", "r", &Milestone{}); err == nil {
+		t.Error("Issues.EnsureMilestone without a title returned nil error, want error")
+	}
+}
+
 func TestIssuesService_CreateMilestone(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
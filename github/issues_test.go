@@ -467,6 +467,100 @@ func TestIssuesService_Unlock(t *testing.T) {
 	})
 }
 
+func TestIssuesService_Lock_invalidReason(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Issues.Lock sent a request with an invalid lock reason")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Issues.Lock(ctx, "o", "r", 1, &LockIssueOptions{LockReason: "bored"}); err == nil {
+		t.Error("Issues.Lock returned nil error, want error")
+	}
+	if _, err := client.Issues.Relock(ctx, "o", "r", 1, "bored"); err == nil {
+		t.Error("Issues.Relock returned nil error, want error")
+	}
+}
+
+func TestIssuesService_Relock(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var calls []string
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls = append(calls, "GET")
+		fmt.Fprint(w, `{"number":1,"locked":true,"active_lock_reason":"off-topic"}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method)
+		if r.Method == "PUT" {
+			testBody(t, r, `{"lock_reason":"resolved"}`+"\n")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Issues.Relock(ctx, "o", "r", 1, LockReasonResolved); err != nil {
+		t.Errorf("Issues.Relock returned error: %v", err)
+	}
+
+	want := []string{"GET", "DELETE", "PUT"}
+	if !cmp.Equal(calls, want) {
+		t.Errorf("Issues.Relock made requests %v, want %v", calls, want)
+	}
+
+	const methodName = "Relock"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Issues.Relock(ctx, "\n", "\n", -1, LockReasonResolved)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Issues.Relock(ctx, "o", "r", 1, LockReasonResolved)
+	})
+}
+
+func TestIssuesService_Relock_sameReason(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"locked":true,"active_lock_reason":"spam"}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Issues.Relock sent a %v request for an issue locked with the same reason", r.Method)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Issues.Relock(ctx, "o", "r", 1, LockReasonSpam); err != nil {
+		t.Errorf("Issues.Relock returned error: %v", err)
+	}
+}
+
+func TestIssuesService_Relock_unlocked(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"locked":false}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"lock_reason":"too heated"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Issues.Relock(ctx, "o", "r", 1, LockReasonTooHeated); err != nil {
+		t.Errorf("Issues.Relock returned error: %v", err)
+	}
+}
+
 func TestIsPullRequest(t *testing.T) {
 	t.Parallel()
 	i := new(Issue)
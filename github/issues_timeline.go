@@ -171,6 +171,38 @@ type Source struct {
 	Issue *Issue  `json:"issue,omitempty"`
 }
 
+// CrossReferenced returns the source of the reference if t is a
+// "cross-referenced" event.
+func (t *Timeline) CrossReferenced() (*Source, bool) {
+	if t.GetEvent() != "cross-referenced" || t.Source == nil {
+		return nil, false
+	}
+	return t.Source, true
+}
+
+// Committed returns the commit that was added if t is a "committed" event.
+func (t *Timeline) Committed() (*Commit, bool) {
+	if t.GetEvent() != "committed" {
+		return nil, false
+	}
+	return &Commit{
+		SHA:       t.SHA,
+		Author:    t.Author,
+		Committer: t.Committer,
+		Message:   t.Message,
+		Parents:   t.Parents,
+		URL:       t.URL,
+	}, true
+}
+
+// Renamed returns the old and new titles if t is a "renamed" event.
+func (t *Timeline) Renamed() (*Rename, bool) {
+	if t.GetEvent() != "renamed" || t.Rename == nil {
+		return nil, false
+	}
+	return t.Rename, true
+}
+
 // ListIssueTimeline lists events for the specified issue.
 //
 // GitHub API docs: https://docs.github.com/rest/issues/timeline#list-timeline-events-for-an-issue
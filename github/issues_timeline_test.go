@@ -343,3 +343,80 @@ func TestTimeline_ReviewRequests(t *testing.T) {
 		t.Errorf("Difference: %s", diff)
 	}
 }
+
+func TestTimeline_typedEvents(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues/1/timeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+		{
+			"event": "cross-referenced",
+			"source": {
+				"type": "issue",
+				"issue": {"number": 2}
+			}
+		},
+		{
+			"event": "committed",
+			"sha": "s",
+			"url": "https://api.github.com/repos/o/r/git/commits/s",
+			"message": "m",
+			"author": {"name": "a"},
+			"committer": {"name": "c"},
+			"parents": [{"sha": "p"}]
+		},
+		{
+			"event": "renamed",
+			"rename": {"from": "old", "to": "new"}
+		}]`)
+	})
+
+	ctx := context.Background()
+	events, _, err := client.Issues.ListIssueTimeline(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListIssueTimeline returned error: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Issues.ListIssueTimeline returned %v events, want 3", len(events))
+	}
+
+	source, ok := events[0].CrossReferenced()
+	if wantSource := (&Source{Type: Ptr("issue"), Issue: &Issue{Number: Ptr(2)}}); !ok || !cmp.Equal(source, wantSource) {
+		t.Errorf("Timeline.CrossReferenced returned %+v, %v, want %+v, true", source, ok, wantSource)
+	}
+
+	commit, ok := events[1].Committed()
+	wantCommit := &Commit{
+		SHA:       Ptr("s"),
+		URL:       Ptr("https://api.github.com/repos/o/r/git/commits/s"),
+		Message:   Ptr("m"),
+		Author:    &CommitAuthor{Name: Ptr("a")},
+		Committer: &CommitAuthor{Name: Ptr("c")},
+		Parents:   []*Commit{{SHA: Ptr("p")}},
+	}
+	if !ok || !cmp.Equal(commit, wantCommit) {
+		t.Errorf("Timeline.Committed returned %+v, %v, want %+v, true", commit, ok, wantCommit)
+	}
+
+	rename, ok := events[2].Renamed()
+	if wantRename := (&Rename{From: Ptr("old"), To: Ptr("new")}); !ok || !cmp.Equal(rename, wantRename) {
+		t.Errorf("Timeline.Renamed returned %+v, %v, want %+v, true", rename, ok, wantRename)
+	}
+
+	// Accessors for a different event kind report false.
+	if _, ok := events[2].CrossReferenced(); ok {
+		t.Error("Timeline.CrossReferenced on a renamed event returned true")
+	}
+	if _, ok := events[0].Committed(); ok {
+		t.Error("Timeline.Committed on a cross-referenced event returned true")
+	}
+	if _, ok := events[1].Renamed(); ok {
+		t.Error("Timeline.Renamed on a committed event returned true")
+	}
+	var nilTimeline *Timeline
+	if _, ok := nilTimeline.Committed(); ok {
+		t.Error("Timeline.Committed on nil returned true")
+	}
+}
@@ -58,7 +58,8 @@ func (l License) String() string {
 	return Stringify(l)
 }
 
-// List popular open source licenses.
+// List popular open source licenses. The returned licenses do not include
+// the license text; use Get to fetch it.
 //
 // GitHub API docs: https://docs.github.com/rest/licenses/licenses#get-all-commonly-used-licenses
 //
@@ -78,7 +79,8 @@ func (s *LicensesService) List(ctx context.Context) ([]*License, *Response, erro
 	return licenses, resp, nil
 }
 
-// Get extended metadata for one license.
+// Get extended metadata for one license, including the full license text
+// in Body.
 //
 // GitHub API docs: https://docs.github.com/rest/licenses/licenses#get-a-license
 //
@@ -152,7 +152,7 @@ func TestLicensesService_Get(t *testing.T) {
 
 	mux.HandleFunc("/licenses/mit", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"key":"mit","name":"MIT"}`)
+		fmt.Fprint(w, `{"key":"mit","name":"MIT","body":"MIT License\n\nCopyright (c) [year] [fullname]\n"}`)
 	})
 
 	ctx := context.Background()
@@ -161,7 +161,7 @@ func TestLicensesService_Get(t *testing.T) {
 		t.Errorf("Licenses.Get returned error: %v", err)
 	}
 
-	want := &License{Key: Ptr("mit"), Name: Ptr("MIT")}
+	want := &License{Key: Ptr("mit"), Name: Ptr("MIT"), Body: Ptr("MIT License\n\nCopyright (c) [year] [fullname]\n")}
 	if !cmp.Equal(license, want) {
 		t.Errorf("Licenses.Get returned %+v, want %+v", license, want)
 	}
@@ -67,3 +67,24 @@ func (s *MarkdownService) Render(ctx context.Context, text string, opts *Markdow
 
 	return buf.String(), resp, nil
 }
+
+// RenderGFM renders text as GitHub Flavored Markdown, the way comments and
+// issues are rendered. If contextRepo is non-empty (in the form "owner/repo"),
+// issue and pull request references such as #123 are resolved against it.
+//
+// GitHub API docs: https://docs.github.com/rest/markdown/markdown#render-a-markdown-document
+//
+//meta:operation POST /markdown
+func (s *MarkdownService) RenderGFM(ctx context.Context, text, contextRepo string) (string, *Response, error) {
+	return s.Render(ctx, text, &MarkdownOptions{Mode: "gfm", Context: contextRepo})
+}
+
+// RenderRaw renders text in plain "markdown" mode, the way README files are
+// rendered.
+//
+// GitHub API docs: https://docs.github.com/rest/markdown/markdown#render-a-markdown-document
+//
+//meta:operation POST /markdown
+func (s *MarkdownService) RenderRaw(ctx context.Context, text string) (string, *Response, error) {
+	return s.Render(ctx, text, &MarkdownOptions{Mode: "markdown"})
+}
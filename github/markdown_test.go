@@ -61,6 +61,82 @@ func TestMarkdownService_Markdown(t *testing.T) {
 	})
 }
 
+func TestMarkdownService_RenderGFM(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"text":"see #1","mode":"gfm","context":"google/go-github"}`+"\n")
+		fmt.Fprint(w, `<p>see <a href="#">#1</a></p>`)
+	})
+
+	ctx := context.Background()
+	md, _, err := client.Markdown.RenderGFM(ctx, "see #1", "google/go-github")
+	if err != nil {
+		t.Errorf("RenderGFM returned error: %v", err)
+	}
+
+	if want := `<p>see <a href="#">#1</a></p>`; want != md {
+		t.Errorf("RenderGFM returned %+v, want %+v", md, want)
+	}
+
+	const methodName = "RenderGFM"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Markdown.RenderGFM(ctx, "see #1", "google/go-github")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestMarkdownService_RenderGFM_noContext(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"text":"# text #","mode":"gfm"}`+"\n")
+		fmt.Fprint(w, `<h1>text</h1>`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Markdown.RenderGFM(ctx, "# text #", ""); err != nil {
+		t.Errorf("RenderGFM returned error: %v", err)
+	}
+}
+
+func TestMarkdownService_RenderRaw(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"text":"# text #","mode":"markdown"}`+"\n")
+		fmt.Fprint(w, `<h1>text</h1>`)
+	})
+
+	ctx := context.Background()
+	md, _, err := client.Markdown.RenderRaw(ctx, "# text #")
+	if err != nil {
+		t.Errorf("RenderRaw returned error: %v", err)
+	}
+
+	if want := "<h1>text</h1>"; want != md {
+		t.Errorf("RenderRaw returned %+v, want %+v", md, want)
+	}
+
+	const methodName = "RenderRaw"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Markdown.RenderRaw(ctx, "# text #")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestMarkdownRenderRequest_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &markdownRenderRequest{}, "{}")
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 )
 
@@ -95,6 +96,65 @@ type APIMetaArtifactAttestations struct {
 	Services    []string `json:"services,omitempty"`
 }
 
+// ParseHooks parses the Hooks CIDR ranges.
+func (m *APIMeta) ParseHooks() ([]*net.IPNet, error) {
+	return parseCIDRs(m.Hooks)
+}
+
+// ParseWeb parses the Web CIDR ranges.
+func (m *APIMeta) ParseWeb() ([]*net.IPNet, error) {
+	return parseCIDRs(m.Web)
+}
+
+// ParseAPI parses the API CIDR ranges.
+func (m *APIMeta) ParseAPI() ([]*net.IPNet, error) {
+	return parseCIDRs(m.API)
+}
+
+// ParseGit parses the Git CIDR ranges.
+func (m *APIMeta) ParseGit() ([]*net.IPNet, error) {
+	return parseCIDRs(m.Git)
+}
+
+// ParseActions parses the Actions CIDR ranges.
+func (m *APIMeta) ParseActions() ([]*net.IPNet, error) {
+	return parseCIDRs(m.Actions)
+}
+
+// ParseDependabot parses the Dependabot CIDR ranges.
+func (m *APIMeta) ParseDependabot() ([]*net.IPNet, error) {
+	return parseCIDRs(m.Dependabot)
+}
+
+// IsGitHubHookIP reports whether ip belongs to one of the Hooks CIDR ranges,
+// that is, whether a webhook delivery from ip may originate from GitHub.
+// Malformed ranges are ignored.
+func (m *APIMeta) IsGitHubHookIP(ip net.IP) bool {
+	if m == nil || ip == nil {
+		return false
+	}
+	for _, cidr := range m.Hooks {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCIDRs parses a list of IP addresses in CIDR notation.
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	ipNets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+	return ipNets, nil
+}
+
 // Get returns information about GitHub.com, the service. Or, if you access
 // this endpoint on your organization’s GitHub Enterprise installation, this
 // endpoint provides information about that installation.
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"testing"
 
@@ -197,3 +198,78 @@ func TestMetaService_Zen(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestAPIMeta_ParseCIDRs(t *testing.T) {
+	t.Parallel()
+	m := &APIMeta{
+		Hooks:      []string{"192.30.252.0/22", "2a0a:a440::/29"},
+		Web:        []string{"140.82.112.0/20"},
+		API:        []string{"20.201.28.148/32"},
+		Git:        []string{"140.82.112.0/20"},
+		Actions:    []string{"4.175.114.51/32"},
+		Dependabot: []string{"192.168.7.0/24"},
+	}
+
+	tests := []struct {
+		name  string
+		parse func() ([]*net.IPNet, error)
+		want  []string
+	}{
+		{"Hooks", m.ParseHooks, []string{"192.30.252.0/22", "2a0a:a440::/29"}},
+		{"Web", m.ParseWeb, []string{"140.82.112.0/20"}},
+		{"API", m.ParseAPI, []string{"20.201.28.148/32"}},
+		{"Git", m.ParseGit, []string{"140.82.112.0/20"}},
+		{"Actions", m.ParseActions, []string{"4.175.114.51/32"}},
+		{"Dependabot", m.ParseDependabot, []string{"192.168.7.0/24"}},
+	}
+
+	for _, tt := range tests {
+		ipNets, err := tt.parse()
+		if err != nil {
+			t.Errorf("Parse%v returned error: %v", tt.name, err)
+			continue
+		}
+		var got []string
+		for _, ipNet := range ipNets {
+			got = append(got, ipNet.String())
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("Parse%v returned %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	bad := &APIMeta{Hooks: []string{"192.30.252.0/22", "not-a-cidr"}}
+	if _, err := bad.ParseHooks(); err == nil {
+		t.Error("ParseHooks returned nil error for a malformed range, want error")
+	}
+}
+
+func TestAPIMeta_IsGitHubHookIP(t *testing.T) {
+	t.Parallel()
+	m := &APIMeta{Hooks: []string{"not-a-cidr", "192.30.252.0/22", "2a0a:a440::/29"}}
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"192.30.252.1", true},
+		{"192.30.255.255", true},
+		{"192.30.251.255", false},
+		{"2a0a:a440::1", true},
+		{"2001:db8::1", false},
+	}
+
+	for _, tt := range tests {
+		if got := m.IsGitHubHookIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("IsGitHubHookIP(%v) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+
+	if m.IsGitHubHookIP(nil) {
+		t.Error("IsGitHubHookIP(nil) = true, want false")
+	}
+	var nilMeta *APIMeta
+	if nilMeta.IsGitHubHookIP(net.ParseIP("192.30.252.1")) {
+		t.Error("nil APIMeta IsGitHubHookIP = true, want false")
+	}
+}
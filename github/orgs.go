@@ -275,6 +275,32 @@ func (s *OrganizationsService) Edit(ctx context.Context, name string, org *Organ
 	return o, resp, nil
 }
 
+// SetDefaultRepoPermission sets the base permission that organization
+// members have on the organization's repositories, leaving all other
+// settings unchanged. perm must be one of "read", "write", "admin" or "none".
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#update-an-organization
+//
+//meta:operation PATCH /orgs/{org}
+func (s *OrganizationsService) SetDefaultRepoPermission(ctx context.Context, org, perm string) (*Organization, *Response, error) {
+	switch perm {
+	case "read", "write", "admin", "none":
+	default:
+		return nil, nil, fmt.Errorf("invalid default repository permission %q: must be one of read, write, admin or none", perm)
+	}
+	return s.Edit(ctx, org, &Organization{DefaultRepoPermission: Ptr(perm)})
+}
+
+// SetMembersCanCreateRepos sets whether organization members can create
+// repositories, leaving all other settings unchanged.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#update-an-organization
+//
+//meta:operation PATCH /orgs/{org}
+func (s *OrganizationsService) SetMembersCanCreateRepos(ctx context.Context, org string, enabled bool) (*Organization, *Response, error) {
+	return s.Edit(ctx, org, &Organization{MembersCanCreateRepos: Ptr(enabled)})
+}
+
 // Delete an organization by name.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/orgs#delete-an-organization
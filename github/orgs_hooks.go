@@ -61,12 +61,16 @@ func (s *OrganizationsService) GetHook(ctx context.Context, org string, id int64
 // Config is a required field.
 //
 // Note that only a subset of the hook fields are used and hook must
-// not be nil.
+// not be nil. If set, hook.Config.ContentType must be "json" or "form".
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/webhooks#create-an-organization-webhook
 //
 //meta:operation POST /orgs/{org}/hooks
 func (s *OrganizationsService) CreateHook(ctx context.Context, org string, hook *Hook) (*Hook, *Response, error) {
+	if err := hook.Config.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("orgs/%v/hooks", org)
 
 	hookReq := &createHookRequest{
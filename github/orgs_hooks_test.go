@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -107,6 +108,31 @@ func TestOrganizationsService_CreateHook(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_CreateHook_invalidContentType(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	input := &Hook{
+		Config: &HookConfig{
+			ContentType: Ptr("xml"),
+			URL:         Ptr("https://example.com/webhook"),
+			Secret:      Ptr("s3cr3t"),
+		},
+	}
+
+	ctx := context.Background()
+	_, resp, err := client.Organizations.CreateHook(ctx, "o", input)
+	if err == nil {
+		t.Fatal("Organizations.CreateHook returned nil error, want error")
+	}
+	if resp != nil {
+		t.Errorf("Organizations.CreateHook returned response %+v, want nil", resp)
+	}
+	if strings.Contains(err.Error(), "s3cr3t") {
+		t.Errorf("Organizations.CreateHook error %q contains the webhook secret", err)
+	}
+}
+
 func TestOrganizationsService_GetHook(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
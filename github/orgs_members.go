@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // Membership represents the status of a user's membership in an organization or team.
@@ -338,6 +339,36 @@ func (s *OrganizationsService) ListPendingOrgInvitations(ctx context.Context, or
 	return pendingInvitations, resp, nil
 }
 
+// FindPendingInvitationByEmail pages through the pending invitations of org
+// and returns the one sent to email. Emails are compared case-insensitively.
+// If there is no such invitation, a nil *Invitation and a nil error are
+// returned. Invitations sent to a GitHub user rather than an email address
+// are never matched.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-pending-organization-invitations
+//
+//meta:operation GET /orgs/{org}/invitations
+func (s *OrganizationsService) FindPendingInvitationByEmail(ctx context.Context, org, email string) (*Invitation, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		invitations, resp, err := s.ListPendingOrgInvitations(ctx, org, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, invitation := range invitations {
+			if invitation.Email != nil && strings.EqualFold(invitation.GetEmail(), email) {
+				return invitation, resp, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // CreateOrgInvitationOptions specifies the parameters to the OrganizationService.Invite
 // method.
 type CreateOrgInvitationOptions struct {
@@ -659,6 +659,50 @@ func TestOrganizationsService_ListPendingOrgInvitations(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_FindPendingInvitationByEmail(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/orgs/o/invitations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/orgs/o/invitations?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"id":1,"login":"a"},{"id":2,"email":"other@example.com"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3,"email":"Octocat@Example.com"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	invitation, _, err := client.Organizations.FindPendingInvitationByEmail(ctx, "o", "octocat@example.com")
+	if err != nil {
+		t.Errorf("Organizations.FindPendingInvitationByEmail returned error: %v", err)
+	}
+
+	want := &Invitation{ID: Ptr(int64(3)), Email: Ptr("Octocat@Example.com")}
+	if !cmp.Equal(invitation, want) {
+		t.Errorf("Organizations.FindPendingInvitationByEmail returned %+v, want %+v", invitation, want)
+	}
+
+	invitation, _, err = client.Organizations.FindPendingInvitationByEmail(ctx, "o", "missing@example.com")
+	if err != nil {
+		t.Errorf("Organizations.FindPendingInvitationByEmail returned error: %v", err)
+	}
+	if invitation != nil {
+		t.Errorf("Organizations.FindPendingInvitationByEmail returned %+v, want nil", invitation)
+	}
+
+	const methodName = "FindPendingInvitationByEmail"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.FindPendingInvitationByEmail(ctx, "\n", "e")
+		return err
+	})
+}
+
 func TestOrganizationsService_CreateOrgInvitation(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -7,7 +7,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // ListOutsideCollaboratorsOptions specifies optional parameters to the
@@ -67,21 +70,53 @@ func (s *OrganizationsService) RemoveOutsideCollaborator(ctx context.Context, or
 	return s.client.Do(ctx, req, nil)
 }
 
+// ConvertToOutsideCollaboratorOptions specifies optional parameters to the
+// OrganizationsService.ConvertMemberToOutsideCollaborator method.
+type ConvertToOutsideCollaboratorOptions struct {
+	// Async, when true, performs the conversion asynchronously. GitHub then
+	// responds with 202 Accepted once the job has been queued.
+	Async bool `json:"async,omitempty"`
+}
+
+// LastOwnerError is returned by ConvertMemberToOutsideCollaborator when the
+// user is the last owner of the organization and therefore cannot be
+// converted to an outside collaborator.
+type LastOwnerError struct {
+	*ErrorResponse
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *LastOwnerError) Unwrap() error { return e.ErrorResponse }
+
 // ConvertMemberToOutsideCollaborator reduces the permission level of a member of the
 // organization to that of an outside collaborator. Therefore, they will only
 // have access to the repositories that their current team membership allows.
-// Responses for converting a non-member or the last owner to an outside collaborator
-// are listed in GitHub API docs.
+// Converting the last owner of the organization returns a *LastOwnerError.
+// Responses for converting a non-member are listed in GitHub API docs.
+//
+// If opts.Async is true, the conversion is queued and a nil error is returned
+// with a 202 Accepted response.
 //
 // GitHub API docs: https://docs.github.com/rest/orgs/outside-collaborators#convert-an-organization-member-to-outside-collaborator
 //
 //meta:operation PUT /orgs/{org}/outside_collaborators/{username}
-func (s *OrganizationsService) ConvertMemberToOutsideCollaborator(ctx context.Context, org string, user string) (*Response, error) {
+func (s *OrganizationsService) ConvertMemberToOutsideCollaborator(ctx context.Context, org string, user string, opts *ConvertToOutsideCollaboratorOptions) (*Response, error) {
 	u := fmt.Sprintf("orgs/%v/outside_collaborators/%v", org, user)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	var aerr *AcceptedError
+	if errors.As(err, &aerr) {
+		return resp, nil
+	}
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusForbidden &&
+		strings.Contains(strings.ToLower(errResp.Message), "last owner") {
+		return resp, &LastOwnerError{ErrorResponse: errResp}
+	}
+
+	return resp, err
 }
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -140,19 +141,19 @@ func TestOrganizationsService_ConvertMemberToOutsideCollaborator(t *testing.T) {
 	mux.HandleFunc("/orgs/o/outside_collaborators/u", handler)
 
 	ctx := context.Background()
-	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
 	if err != nil {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error: %v", err)
 	}
 
 	const methodName = "ConvertMemberToOutsideCollaborator"
 	testBadOptions(t, methodName, func() (err error) {
-		_, err = client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "\n", "\n")
+		_, err = client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "\n", "\n", nil)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		return client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+		return client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
 	})
 }
 
@@ -167,10 +168,56 @@ func TestOrganizationsService_ConvertMemberToOutsideCollaborator_NonMemberOrLast
 	mux.HandleFunc("/orgs/o/outside_collaborators/u", handler)
 
 	ctx := context.Background()
-	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u")
+	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
 	if err, ok := err.(*ErrorResponse); !ok {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator did not return an error")
 	} else if err.Response.StatusCode != http.StatusForbidden {
 		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator did not return 403 status code")
 	}
 }
+
+func TestOrganizationsService_ConvertMemberToOutsideCollaborator_async(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/outside_collaborators/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"async":true}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	opts := &ConvertToOutsideCollaboratorOptions{Async: true}
+	resp, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", opts)
+	if err != nil {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Organizations.ConvertMemberToOutsideCollaborator returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+}
+
+func TestOrganizationsService_ConvertMemberToOutsideCollaborator_lastOwner(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/outside_collaborators/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Cannot convert the last owner to an outside collaborator"}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.ConvertMemberToOutsideCollaborator(ctx, "o", "u", nil)
+	var lerr *LastOwnerError
+	if !errors.As(err, &lerr) {
+		t.Fatalf("Organizations.ConvertMemberToOutsideCollaborator returned error %v, want *LastOwnerError", err)
+	}
+	if lerr.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("LastOwnerError status = %v, want %v", lerr.Response.StatusCode, http.StatusForbidden)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Error("LastOwnerError does not unwrap to *ErrorResponse")
+	}
+}
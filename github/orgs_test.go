@@ -307,6 +307,92 @@ func TestOrganizationsService_Edit(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_SetDefaultRepoPermission(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"default_repository_permission":"write"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"default_repository_permission":"write"}`)
+	})
+
+	ctx := context.Background()
+	org, _, err := client.Organizations.SetDefaultRepoPermission(ctx, "o", "write")
+	if err != nil {
+		t.Errorf("Organizations.SetDefaultRepoPermission returned error: %v", err)
+	}
+
+	want := &Organization{ID: Ptr(int64(1)), DefaultRepoPermission: Ptr("write")}
+	if !cmp.Equal(org, want) {
+		t.Errorf("Organizations.SetDefaultRepoPermission returned %+v, want %+v", org, want)
+	}
+
+	const methodName = "SetDefaultRepoPermission"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SetDefaultRepoPermission(ctx, "\n", "write")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.SetDefaultRepoPermission(ctx, "o", "write")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_SetDefaultRepoPermission_invalidPerm(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, resp, err := client.Organizations.SetDefaultRepoPermission(ctx, "o", "maintain")
+	if err == nil {
+		t.Error("Organizations.SetDefaultRepoPermission returned nil error, want error")
+	}
+	if resp != nil {
+		t.Errorf("Organizations.SetDefaultRepoPermission returned response %+v, want nil", resp)
+	}
+}
+
+func TestOrganizationsService_SetMembersCanCreateRepos(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"members_can_create_repositories":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"members_can_create_repositories":false}`)
+	})
+
+	ctx := context.Background()
+	org, _, err := client.Organizations.SetMembersCanCreateRepos(ctx, "o", false)
+	if err != nil {
+		t.Errorf("Organizations.SetMembersCanCreateRepos returned error: %v", err)
+	}
+
+	want := &Organization{ID: Ptr(int64(1)), MembersCanCreateRepos: Ptr(false)}
+	if !cmp.Equal(org, want) {
+		t.Errorf("Organizations.SetMembersCanCreateRepos returned %+v, want %+v", org, want)
+	}
+
+	const methodName = "SetMembersCanCreateRepos"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.SetMembersCanCreateRepos(ctx, "\n", false)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.SetMembersCanCreateRepos(ctx, "o", false)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
@@ -202,6 +202,49 @@ func (s *PullRequestsService) ListPullRequestsWithCommit(ctx context.Context, ow
 	return pulls, resp, nil
 }
 
+// ListPullRequestsWithCommitByState is like ListPullRequestsWithCommit, but
+// only returns the pull requests in the given state, filtered client-side.
+// state can be "open", "closed" (which includes merged pull requests) or
+// "merged". An empty state returns all pull requests.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#list-pull-requests-associated-with-a-commit
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/pulls
+func (s *PullRequestsService) ListPullRequestsWithCommitByState(ctx context.Context, owner, repo, sha, state string, opts *ListOptions) ([]*PullRequest, *Response, error) {
+	switch state {
+	case "", "open", "closed", "merged":
+	default:
+		return nil, nil, fmt.Errorf("invalid pull request state %q", state)
+	}
+
+	pulls, resp, err := s.ListPullRequestsWithCommit(ctx, owner, repo, sha, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	filtered := make([]*PullRequest, 0, len(pulls))
+	for _, pull := range pulls {
+		if pullRequestHasState(pull, state) {
+			filtered = append(filtered, pull)
+		}
+	}
+
+	return filtered, resp, nil
+}
+
+// pullRequestHasState reports whether pull is in the given state, as accepted
+// by ListPullRequestsWithCommitByState.
+func pullRequestHasState(pull *PullRequest, state string) bool {
+	switch state {
+	case "":
+		return true
+	case "merged":
+		return pull.MergedAt != nil
+	default:
+		return pull.GetState() == state
+	}
+}
+
 // Get a single pull request.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
@@ -223,6 +266,61 @@ func (s *PullRequestsService) Get(ctx context.Context, owner string, repo string
 	return pull, resp, nil
 }
 
+// MarkReadyForReview marks a draft pull request as ready for review and
+// returns the updated pull request.
+//
+// There is no REST endpoint for this operation, so it is performed with the
+// markPullRequestReadyForReview GraphQL mutation. The token used must
+// therefore be allowed to access the GraphQL API. See
+// https://docs.github.com/graphql/reference/mutations#markpullrequestreadyforreview
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+//
+//meta:operation POST /graphql
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+func (s *PullRequestsService) MarkReadyForReview(ctx context.Context, owner, repo string, number int) (*PullRequest, *Response, error) {
+	const mutation = `mutation($id: ID!) { markPullRequestReadyForReview(input: {pullRequestId: $id}) { pullRequest { id } } }`
+	return s.setDraft(ctx, owner, repo, number, mutation)
+}
+
+// ConvertToDraft converts a pull request to a draft and returns the updated
+// pull request.
+//
+// There is no REST endpoint for this operation, so it is performed with the
+// convertPullRequestToDraft GraphQL mutation. The token used must therefore
+// be allowed to access the GraphQL API. See
+// https://docs.github.com/graphql/reference/mutations#convertpullrequesttodraft
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+//
+//meta:operation POST /graphql
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+func (s *PullRequestsService) ConvertToDraft(ctx context.Context, owner, repo string, number int) (*PullRequest, *Response, error) {
+	const mutation = `mutation($id: ID!) { convertPullRequestToDraft(input: {pullRequestId: $id}) { pullRequest { id } } }`
+	return s.setDraft(ctx, owner, repo, number, mutation)
+}
+
+// setDraft looks up the node ID of the pull request, runs mutation against it
+// and fetches the updated pull request.
+func (s *PullRequestsService) setDraft(ctx context.Context, owner, repo string, number int, mutation string) (*PullRequest, *Response, error) {
+	pull, resp, err := s.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+	if pull.GetNodeID() == "" {
+		return nil, resp, errors.New("pull request has no node ID")
+	}
+
+	resp, err = s.client.doGraphQL(ctx, mutation, map[string]interface{}{"id": pull.GetNodeID()}, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.Get(ctx, owner, repo, number)
+}
+
 // GetRaw gets a single pull request in raw (diff or patch) format.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
@@ -415,6 +513,69 @@ func (s *PullRequestsService) ListCommits(ctx context.Context, owner string, rep
 	return commits, resp, nil
 }
 
+// maxPullRequestCommits is the maximum number of commits listed by
+// PullRequestsService.ListCommits.
+const maxPullRequestCommits = 250
+
+// GetAllCommits lists all the commits in a pull request, following
+// pagination. Commits are returned in the order GitHub lists them, which is
+// chronological with the oldest first.
+//
+// GitHub lists at most 250 commits for a pull request. When the pull request
+// has more, the first 250 are returned and the second return value is true;
+// use RepositoriesService.CompareCommits to list the rest.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#list-commits-on-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/commits
+func (s *PullRequestsService) GetAllCommits(ctx context.Context, owner, repo string, number int) ([]*RepositoryCommit, bool, *Response, error) {
+	var (
+		commits []*RepositoryCommit
+		resp    *Response
+	)
+	opts := &ListOptions{PerPage: 100}
+	for {
+		page, r, err := s.ListCommits(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, false, r, err
+		}
+		resp = r
+		commits = append(commits, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if len(commits) < maxPullRequestCommits {
+		return commits, false, resp, nil
+	}
+
+	// The list may have hit the cap; compare with the pull request's count.
+	count, r, err := s.GetCommitCount(ctx, owner, repo, number)
+	if err != nil {
+		return nil, false, r, err
+	}
+	return commits, count > len(commits), resp, nil
+}
+
+// GetCommitCount returns the number of commits in a pull request, as
+// reported by the pull request itself, without listing the commits.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#get-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}
+func (s *PullRequestsService) GetCommitCount(ctx context.Context, owner, repo string, number int) (int, *Response, error) {
+	pull, resp, err := s.Get(ctx, owner, repo, number)
+	if err != nil {
+		return 0, resp, err
+	}
+	return pull.GetCommits(), resp, nil
+}
+
 // ListFiles lists the files in a pull request.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/pulls#list-pull-requests-files
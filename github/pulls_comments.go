@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -152,6 +153,32 @@ func (s *PullRequestsService) CreateComment(ctx context.Context, owner, repo str
 	return c, resp, nil
 }
 
+// CreateReviewCommentOnLine creates a review comment on a pull request using
+// line numbers in the file rather than the deprecated diff Position. Set Line
+// and Side for a single-line comment, and also StartLine and StartSide for a
+// multi-line comment, in which case StartLine must not be greater than Line.
+// Line is not required for comments with a SubjectType of "file".
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/comments#create-a-review-comment-for-a-pull-request
+//
+//meta:operation POST /repos/{owner}/{repo}/pulls/{pull_number}/comments
+func (s *PullRequestsService) CreateReviewCommentOnLine(ctx context.Context, owner, repo string, number int, comment *PullRequestComment) (*PullRequestComment, *Response, error) {
+	if comment == nil {
+		return nil, nil, errors.New("comment must be provided")
+	}
+	if comment.Position != nil {
+		return nil, nil, errors.New("comment position must not be set; use Line and Side instead")
+	}
+	if comment.GetSubjectType() != "file" && comment.Line == nil {
+		return nil, nil, errors.New("comment line must be provided")
+	}
+	if comment.StartLine != nil && comment.GetStartLine() > comment.GetLine() {
+		return nil, nil, fmt.Errorf("comment start line %v is after line %v", comment.GetStartLine(), comment.GetLine())
+	}
+
+	return s.CreateComment(ctx, owner, repo, number, comment)
+}
+
 // CreateCommentInReplyTo creates a new comment as a reply to an existing pull request comment.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/comments#create-a-review-comment-for-a-pull-request
@@ -308,6 +308,101 @@ func TestPullRequestsService_CreateComment(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_CreateReviewCommentOnLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		comment  *PullRequestComment
+		wantBody string
+	}{
+		{
+			name: "single line",
+			comment: &PullRequestComment{
+				Body:     Ptr("b"),
+				CommitID: Ptr("s"),
+				Path:     Ptr("main.go"),
+				Line:     Ptr(5),
+				Side:     Ptr("RIGHT"),
+			},
+			wantBody: `{"body":"b","path":"main.go","line":5,"side":"RIGHT","commit_id":"s"}`,
+		},
+		{
+			name: "multi line",
+			comment: &PullRequestComment{
+				Body:      Ptr("b"),
+				CommitID:  Ptr("s"),
+				Path:      Ptr("main.go"),
+				StartLine: Ptr(3),
+				Line:      Ptr(5),
+				StartSide: Ptr("RIGHT"),
+				Side:      Ptr("RIGHT"),
+			},
+			wantBody: `{"body":"b","path":"main.go","start_line":3,"line":5,"side":"RIGHT","start_side":"RIGHT","commit_id":"s"}`,
+		},
+		{
+			name: "file",
+			comment: &PullRequestComment{
+				Body:        Ptr("b"),
+				CommitID:    Ptr("s"),
+				Path:        Ptr("main.go"),
+				SubjectType: Ptr("file"),
+			},
+			wantBody: `{"body":"b","path":"main.go","commit_id":"s","subject_type":"file"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			mux.HandleFunc("/repos/o/r/pulls/1/comments", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, tt.wantBody+"\n")
+				fmt.Fprint(w, `{"id":1}`)
+			})
+
+			ctx := context.Background()
+			comment, _, err := client.PullRequests.CreateReviewCommentOnLine(ctx, "o", "r", 1, tt.comment)
+			if err != nil {
+				t.Fatalf("PullRequests.CreateReviewCommentOnLine returned error: %v", err)
+			}
+
+			want := &PullRequestComment{ID: Ptr(int64(1))}
+			if !cmp.Equal(comment, want) {
+				t.Errorf("PullRequests.CreateReviewCommentOnLine returned %+v, want %+v", comment, want)
+			}
+
+			const methodName = "CreateReviewCommentOnLine"
+			testBadOptions(t, methodName, func() (err error) {
+				_, _, err = client.PullRequests.CreateReviewCommentOnLine(ctx, "\n", "\n", -1, tt.comment)
+				return err
+			})
+		})
+	}
+}
+
+func TestPullRequestsService_CreateReviewCommentOnLine_invalid(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pulls/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent for an invalid comment")
+	})
+
+	ctx := context.Background()
+	for _, comment := range []*PullRequestComment{
+		nil,
+		{Body: Ptr("b"), Path: Ptr("main.go")},
+		{Body: Ptr("b"), Path: Ptr("main.go"), Line: Ptr(5), Position: Ptr(2)},
+		{Body: Ptr("b"), Path: Ptr("main.go"), StartLine: Ptr(6), Line: Ptr(5)},
+	} {
+		if _, _, err := client.PullRequests.CreateReviewCommentOnLine(ctx, "o", "r", 1, comment); err == nil {
+			t.Errorf("PullRequests.CreateReviewCommentOnLine(%+v) returned nil error, want error", comment)
+		}
+	}
+}
+
 func TestPullRequestsService_CreateComment_invalidOwner(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
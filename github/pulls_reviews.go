@@ -63,6 +63,20 @@ func (r PullRequestReviewRequest) String() string {
 	return Stringify(r)
 }
 
+// LineComment is an inline comment on a single line of a pull request's
+// diff, as used by PullRequestsService.CreateReviewWithLineComments.
+type LineComment struct {
+	// Path is the path of the file to comment on.
+	Path string
+	// Line is the line number in the file to comment on.
+	Line int
+	// Side is the side of the diff the line belongs to: "RIGHT" for the new
+	// version of the file (the default if empty) or "LEFT" for the old one.
+	Side string
+	// Body is the text of the comment.
+	Body string
+}
+
 func (r *PullRequestReviewRequest) isComfortFadePreview() (bool, error) {
 	var isCF *bool
 	for _, comment := range r.Comments {
@@ -259,6 +273,47 @@ func (s *PullRequestsService) CreateReview(ctx context.Context, owner, repo stri
 	return r, resp, nil
 }
 
+// CreateReviewWithLineComments creates a review with inline comments on
+// single lines of a pull request. It builds the PullRequestReviewRequest from
+// comments, using the line form of DraftReviewComment, and calls CreateReview.
+// event must be one of "APPROVE", "REQUEST_CHANGES" or "COMMENT".
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/reviews#create-a-review-for-a-pull-request
+//
+//meta:operation POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews
+func (s *PullRequestsService) CreateReviewWithLineComments(ctx context.Context, owner, repo string, number int, event, body string, comments []LineComment) (*PullRequestReview, *Response, error) {
+	switch event {
+	case "APPROVE", "REQUEST_CHANGES", "COMMENT":
+	default:
+		return nil, nil, fmt.Errorf("invalid review event %q: must be APPROVE, REQUEST_CHANGES or COMMENT", event)
+	}
+
+	review := &PullRequestReviewRequest{Event: Ptr(event)}
+	if body != "" {
+		review.Body = Ptr(body)
+	}
+	for i, c := range comments {
+		if c.Path == "" || c.Line <= 0 {
+			return nil, nil, fmt.Errorf("comment %v: path and a positive line must be provided", i)
+		}
+		side := c.Side
+		if side == "" {
+			side = "RIGHT"
+		}
+		if side != "RIGHT" && side != "LEFT" {
+			return nil, nil, fmt.Errorf("comment %v: invalid side %q: must be RIGHT or LEFT", i, c.Side)
+		}
+		review.Comments = append(review.Comments, &DraftReviewComment{
+			Path: Ptr(c.Path),
+			Body: Ptr(c.Body),
+			Side: Ptr(side),
+			Line: Ptr(c.Line),
+		})
+	}
+
+	return s.CreateReview(ctx, owner, repo, number, review)
+}
+
 // UpdateReview updates the review summary on the specified pull request.
 //
 // GitHub API docs: https://docs.github.com/rest/pulls/reviews#update-a-review-for-a-pull-request
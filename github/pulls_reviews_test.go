@@ -488,6 +488,79 @@ func TestPullRequestsService_CreateReview_addHeader(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_CreateReviewWithLineComments(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pulls/1/reviews", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeMultiLineCommentsPreview)
+		v := new(PullRequestReviewRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		want := &PullRequestReviewRequest{
+			Body:  Ptr("Needs work"),
+			Event: Ptr("REQUEST_CHANGES"),
+			Comments: []*DraftReviewComment{
+				{Path: Ptr("main.go"), Body: Ptr("Handle the error."), Side: Ptr("RIGHT"), Line: Ptr(12)},
+				{Path: Ptr("util.go"), Body: Ptr("Why was this removed?"), Side: Ptr("LEFT"), Line: Ptr(3)},
+			},
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"id":1,"state":"CHANGES_REQUESTED"}`)
+	})
+
+	comments := []LineComment{
+		{Path: "main.go", Line: 12, Body: "Handle the error."},
+		{Path: "util.go", Line: 3, Side: "LEFT", Body: "Why was this removed?"},
+	}
+	ctx := context.Background()
+	review, _, err := client.PullRequests.CreateReviewWithLineComments(ctx, "o", "r", 1, "REQUEST_CHANGES", "Needs work", comments)
+	if err != nil {
+		t.Fatalf("PullRequests.CreateReviewWithLineComments returned error: %v", err)
+	}
+
+	want := &PullRequestReview{ID: Ptr(int64(1)), State: Ptr("CHANGES_REQUESTED")}
+	if !cmp.Equal(review, want) {
+		t.Errorf("PullRequests.CreateReviewWithLineComments returned %+v, want %+v", review, want)
+	}
+
+	const methodName = "CreateReviewWithLineComments"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.CreateReviewWithLineComments(ctx, "\n", "\n", -1, "COMMENT", "", comments)
+		return err
+	})
+}
+
+func TestPullRequestsService_CreateReviewWithLineComments_invalid(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pulls/1/reviews", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent for an invalid review")
+	})
+
+	tests := []struct {
+		name     string
+		event    string
+		comments []LineComment
+	}{
+		{name: "event", event: "approve"},
+		{name: "missing path", event: "COMMENT", comments: []LineComment{{Line: 1, Body: "b"}}},
+		{name: "missing line", event: "COMMENT", comments: []LineComment{{Path: "main.go", Body: "b"}}},
+		{name: "side", event: "COMMENT", comments: []LineComment{{Path: "main.go", Line: 1, Side: "BOTH", Body: "b"}}},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		if _, _, err := client.PullRequests.CreateReviewWithLineComments(ctx, "o", "r", 1, tt.event, "", tt.comments); err == nil {
+			t.Errorf("%v: PullRequests.CreateReviewWithLineComments returned nil error, want error", tt.name)
+		}
+	}
+}
+
 func TestPullRequestsService_UpdateReview(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
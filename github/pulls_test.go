@@ -101,6 +101,62 @@ func TestPullRequestsService_ListPullRequestsWithCommit(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_ListPullRequestsWithCommitByState(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/sha/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"number":1,"state":"open"},
+			{"number":2,"state":"closed","merged_at":"2006-01-02T15:04:05Z"},
+			{"number":3,"state":"closed"}
+		]`)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		state string
+		want  []int
+	}{
+		{state: "", want: []int{1, 2, 3}},
+		{state: "open", want: []int{1}},
+		{state: "closed", want: []int{2, 3}},
+		{state: "merged", want: []int{2}},
+	}
+	for _, tt := range tests {
+		pulls, _, err := client.PullRequests.ListPullRequestsWithCommitByState(ctx, "o", "r", "sha", tt.state, nil)
+		if err != nil {
+			t.Errorf("PullRequests.ListPullRequestsWithCommitByState(%q) returned error: %v", tt.state, err)
+		}
+		var got []int
+		for _, pull := range pulls {
+			got = append(got, pull.GetNumber())
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("PullRequests.ListPullRequestsWithCommitByState(%q) returned %v, want %v", tt.state, got, tt.want)
+		}
+	}
+
+	if _, _, err := client.PullRequests.ListPullRequestsWithCommitByState(ctx, "o", "r", "sha", "draft", nil); err == nil {
+		t.Error("PullRequests.ListPullRequestsWithCommitByState with an invalid state returned nil error, want error")
+	}
+
+	const methodName = "ListPullRequestsWithCommitByState"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.ListPullRequestsWithCommitByState(ctx, "\n", "\n", "\n", "open", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ListPullRequestsWithCommitByState(ctx, "o", "r", "sha", "open", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestPullRequestsService_List_invalidOwner(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
@@ -145,6 +201,97 @@ func TestPullRequestsService_Get(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_MarkReadyForReview(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var mutated bool
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"number":1,"node_id":"PR_1","draft":%v}`, !mutated)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"mutation($id: ID!) { markPullRequestReadyForReview(input: {pullRequestId: $id}) { pullRequest { id } } }","variables":{"id":"PR_1"}}`+"\n")
+		mutated = true
+		fmt.Fprint(w, `{"data":{"markPullRequestReadyForReview":{"pullRequest":{"id":"PR_1"}}}}`)
+	})
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.MarkReadyForReview(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.MarkReadyForReview returned error: %v", err)
+	}
+
+	want := &PullRequest{Number: Ptr(1), NodeID: Ptr("PR_1"), Draft: Ptr(false)}
+	if !cmp.Equal(pull, want) {
+		t.Errorf("PullRequests.MarkReadyForReview returned %+v, want %+v", pull, want)
+	}
+
+	const methodName = "MarkReadyForReview"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.MarkReadyForReview(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.MarkReadyForReview(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_ConvertToDraft(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var mutated bool
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"number":1,"node_id":"PR_1","draft":%v}`, mutated)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"mutation($id: ID!) { convertPullRequestToDraft(input: {pullRequestId: $id}) { pullRequest { id } } }","variables":{"id":"PR_1"}}`+"\n")
+		mutated = true
+		fmt.Fprint(w, `{"data":{"convertPullRequestToDraft":{"pullRequest":{"id":"PR_1"}}}}`)
+	})
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.ConvertToDraft(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.ConvertToDraft returned error: %v", err)
+	}
+
+	want := &PullRequest{Number: Ptr(1), NodeID: Ptr("PR_1"), Draft: Ptr(true)}
+	if !cmp.Equal(pull, want) {
+		t.Errorf("PullRequests.ConvertToDraft returned %+v, want %+v", pull, want)
+	}
+}
+
+func TestPullRequestsService_ConvertToDraft_graphQLError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number":1,"node_id":"PR_1"}`)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null,"errors":[{"message":"Resource not accessible by integration"}]}`)
+	})
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.ConvertToDraft(ctx, "o", "r", 1)
+	if err == nil {
+		t.Error("PullRequests.ConvertToDraft returned nil error, want error")
+	}
+	if pull != nil {
+		t.Errorf("PullRequests.ConvertToDraft returned %+v, want nil", pull)
+	}
+}
+
 func TestPullRequestsService_GetRaw_diff(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -596,6 +743,145 @@ func TestPullRequestsService_ListCommits(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_GetAllCommits(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pulls/1/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/pulls/1/commits?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"sha":"1"},{"sha":"2"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"sha":"3"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("pull request fetched although the commit list was not capped")
+	})
+
+	ctx := context.Background()
+	commits, truncated, _, err := client.PullRequests.GetAllCommits(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetAllCommits returned error: %v", err)
+	}
+
+	want := []*RepositoryCommit{{SHA: Ptr("1")}, {SHA: Ptr("2")}, {SHA: Ptr("3")}}
+	if !cmp.Equal(commits, want) {
+		t.Errorf("PullRequests.GetAllCommits returned %+v, want %+v", commits, want)
+	}
+	if truncated {
+		t.Error("PullRequests.GetAllCommits returned truncated = true, want false")
+	}
+
+	const methodName = "GetAllCommits"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.PullRequests.GetAllCommits(ctx, "\n", "\n", -1)
+		return err
+	})
+}
+
+func TestPullRequestsService_GetAllCommits_truncated(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		totalCommits  int
+		wantTruncated bool
+	}{
+		{name: "over cap", totalCommits: 300, wantTruncated: true},
+		{name: "exactly at cap", totalCommits: 250, wantTruncated: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, serverURL := setup(t)
+
+			// GitHub stops listing after 250 commits.
+			mux.HandleFunc("/repos/o/r/pulls/1/commits", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				first, n := 0, 100
+				switch r.FormValue("page") {
+				case "":
+				case "2":
+					first = 100
+				case "3":
+					first, n = 200, 50
+				default:
+					t.Errorf("unexpected page %q", r.FormValue("page"))
+				}
+				if first+n < 250 {
+					w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/pulls/1/commits?per_page=100&page=%v>; rel="next"`, serverURL, first/100+2))
+				}
+				shas := make([]string, n)
+				for i := range shas {
+					shas[i] = fmt.Sprintf(`{"sha":"%v"}`, first+i+1)
+				}
+				fmt.Fprintf(w, "[%v]", strings.Join(shas, ","))
+			})
+			mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprintf(w, `{"number":1,"commits":%v}`, tt.totalCommits)
+			})
+
+			ctx := context.Background()
+			commits, truncated, _, err := client.PullRequests.GetAllCommits(ctx, "o", "r", 1)
+			if err != nil {
+				t.Fatalf("PullRequests.GetAllCommits returned error: %v", err)
+			}
+			if len(commits) != 250 {
+				t.Errorf("PullRequests.GetAllCommits returned %v commits, want 250", len(commits))
+			}
+			if got, want := commits[0].GetSHA(), "1"; got != want {
+				t.Errorf("first commit SHA = %q, want %q", got, want)
+			}
+			if got, want := commits[249].GetSHA(), "250"; got != want {
+				t.Errorf("last commit SHA = %q, want %q", got, want)
+			}
+			if truncated != tt.wantTruncated {
+				t.Errorf("PullRequests.GetAllCommits returned truncated = %v, want %v", truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestPullRequestsService_GetCommitCount(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"commits":7}`)
+	})
+
+	ctx := context.Background()
+	count, _, err := client.PullRequests.GetCommitCount(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.GetCommitCount returned error: %v", err)
+	}
+	if count != 7 {
+		t.Errorf("PullRequests.GetCommitCount returned %v, want 7", count)
+	}
+
+	const methodName = "GetCommitCount"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.GetCommitCount(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.GetCommitCount(ctx, "o", "r", 1)
+		if got != 0 {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want 0", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestPullRequestsService_ListFiles(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -6,13 +6,18 @@
 package github
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 )
 
 const githubBranchNotProtected string = "Branch not protected"
@@ -622,6 +627,28 @@ func (s *RepositoriesService) CreateFromTemplate(ctx context.Context, templateOw
 	return r, resp, nil
 }
 
+// WaitForTemplateGeneration waits for a repository created with
+// CreateFromTemplate to become available. Generating a repository from a
+// template is asynchronous, so the new repository may briefly respond with
+// 404 Not Found. WaitForTemplateGeneration retries with an increasing delay
+// until the repository is found, ctx is done or several attempts have failed,
+// in which case the last error is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}
+func (s *RepositoriesService) WaitForTemplateGeneration(ctx context.Context, owner, repo string) (*Repository, *Response, error) {
+	for attempt := 0; ; attempt++ {
+		repository, resp, err := s.Get(ctx, owner, repo)
+		if resp == nil || resp.StatusCode != http.StatusNotFound || attempt == maxPollAttempts-1 {
+			return repository, resp, err
+		}
+		if err := s.client.sleepBeforePoll(ctx, attempt); err != nil {
+			return nil, resp, err
+		}
+	}
+}
+
 // Get fetches a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#get-a-repository
@@ -723,6 +750,79 @@ func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repo
 	return r, resp, nil
 }
 
+// editableRepositoryFields lists the fields accepted by the "update a
+// repository" endpoint, keyed by their JSON name.
+var editableRepositoryFields = map[string]bool{
+	"allow_auto_merge":               true,
+	"allow_forking":                  true,
+	"allow_merge_commit":             true,
+	"allow_rebase_merge":             true,
+	"allow_squash_merge":             true,
+	"allow_update_branch":            true,
+	"archived":                       true,
+	"default_branch":                 true,
+	"delete_branch_on_merge":         true,
+	"description":                    true,
+	"has_discussions":                true,
+	"has_issues":                     true,
+	"has_projects":                   true,
+	"has_wiki":                       true,
+	"homepage":                       true,
+	"is_template":                    true,
+	"merge_commit_message":           true,
+	"merge_commit_title":             true,
+	"name":                           true,
+	"private":                        true,
+	"security_and_analysis":          true,
+	"squash_merge_commit_message":    true,
+	"squash_merge_commit_title":      true,
+	"use_squash_pr_title_as_default": true,
+	"visibility":                     true,
+	"web_commit_signoff_required":    true,
+}
+
+// Patch updates only the given fields of a repository. Keys of fields are
+// the JSON field names of the "update a repository" endpoint, such as
+// "has_issues" or "description", and values are sent as is. Unlike Edit,
+// which sends every non-nil field of a Repository, this makes it impossible
+// to change a field by accident. Unknown field names are rejected without
+// making a request.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#update-a-repository
+//
+//meta:operation PATCH /repos/{owner}/{repo}
+func (s *RepositoriesService) Patch(ctx context.Context, owner, repo string, fields map[string]interface{}) (*Repository, *Response, error) {
+	if len(fields) == 0 {
+		return nil, nil, errors.New("no repository fields to update")
+	}
+	var unknown []string
+	for name := range fields {
+		if !editableRepositoryFields[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return nil, nil, fmt.Errorf("unknown repository fields: %v", strings.Join(unknown, ", "))
+	}
+
+	u := fmt.Sprintf("repos/%v/%v", owner, repo)
+	req, err := s.client.NewRequest("PATCH", u, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acceptHeaders := []string{mediaTypeRepositoryTemplatePreview, mediaTypeRepositoryVisibilityPreview}
+	req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
+	r := new(Repository)
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
 // Delete a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#delete-a-repository
@@ -769,9 +869,19 @@ type ListContributorsOptions struct {
 	// Include anonymous contributors in results or not
 	Anon string `url:"anon,omitempty"`
 
+	// IncludeAnonymous includes anonymous contributors in the results. It
+	// takes precedence over Anon when set.
+	IncludeAnonymous bool `url:"-"`
+
 	ListOptions
 }
 
+// IsAnonymous reports whether c is an anonymous contributor, identified only
+// by name and email rather than by a GitHub account.
+func (c *Contributor) IsAnonymous() bool {
+	return c.GetType() == "Anonymous"
+}
+
 // GetVulnerabilityAlerts checks if vulnerability alerts are enabled for a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#check-if-vulnerability-alerts-are-enabled-for-a-repository
@@ -890,6 +1000,12 @@ func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context,
 //
 //meta:operation GET /repos/{owner}/{repo}/contributors
 func (s *RepositoriesService) ListContributors(ctx context.Context, owner string, repository string, opts *ListContributorsOptions) ([]*Contributor, *Response, error) {
+	if opts != nil && opts.IncludeAnonymous {
+		o := *opts
+		o.Anon = "true"
+		opts = &o
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/contributors", owner, repository)
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -910,6 +1026,30 @@ func (s *RepositoriesService) ListContributors(ctx context.Context, owner string
 	return contributor, resp, nil
 }
 
+// ListAllContributors lists all the contributors for a repository, following
+// pagination. Anonymous contributors are included if includeAnon is true;
+// they can be told apart with Contributor.IsAnonymous.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-contributors
+//
+//meta:operation GET /repos/{owner}/{repo}/contributors
+func (s *RepositoriesService) ListAllContributors(ctx context.Context, owner, repo string, includeAnon bool) ([]*Contributor, *Response, error) {
+	var all []*Contributor
+	opts := &ListContributorsOptions{IncludeAnonymous: includeAnon, ListOptions: ListOptions{PerPage: 100}}
+	for {
+		contributors, resp, err := s.ListContributors(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, contributors...)
+
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // ListLanguages lists languages for the specified repository. The returned map
 // specifies the languages and the number of bytes of code written in that
 // language. For example:
@@ -938,6 +1078,49 @@ func (s *RepositoriesService) ListLanguages(ctx context.Context, owner string, r
 	return languages, resp, nil
 }
 
+// LanguagePercentage represents the share of a repository's code written in a language.
+type LanguagePercentage struct {
+	Language string  `json:"language"`
+	Bytes    int     `json:"bytes"`
+	Percent  float64 `json:"percent"`
+}
+
+// GetLanguagePercentages lists languages for the specified repository along
+// with the percentage of bytes of code written in each, sorted by descending
+// number of bytes. A repository without any detected language yields an empty slice.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-languages
+//
+//meta:operation GET /repos/{owner}/{repo}/languages
+func (s *RepositoriesService) GetLanguagePercentages(ctx context.Context, owner, repo string) ([]*LanguagePercentage, *Response, error) {
+	languages, resp, err := s.ListLanguages(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var total int
+	for _, bytes := range languages {
+		total += bytes
+	}
+
+	percentages := make([]*LanguagePercentage, 0, len(languages))
+	for language, bytes := range languages {
+		p := &LanguagePercentage{Language: language, Bytes: bytes}
+		if total > 0 {
+			p.Percent = float64(bytes) * 100 / float64(total)
+		}
+		percentages = append(percentages, p)
+	}
+	slices.SortFunc(percentages, func(a, b *LanguagePercentage) int {
+		if c := cmp.Compare(b.Bytes, a.Bytes); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Language, b.Language)
+	})
+
+	return percentages, resp, nil
+}
+
 // ListTeams lists the teams for the specified repository.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-teams
@@ -998,6 +1181,110 @@ func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo s
 	return tags, resp, nil
 }
 
+// RepositoryTagWithDate is a RepositoryTag together with the date of the
+// commit it points to.
+type RepositoryTagWithDate struct {
+	Tag         *RepositoryTag
+	CommittedAt time.Time
+}
+
+// maxConcurrentTagDateRequests bounds the number of commit lookups
+// ListTagsWithDates keeps in flight at once.
+const maxConcurrentTagDateRequests = 4
+
+// ListTagsWithDates lists tags for the specified repository like ListTags,
+// and resolves the committer date of each tagged commit. Annotated tags are
+// dereferenced to the commit they point to. The lookups run concurrently and
+// the first failure cancels the remaining ones.
+//
+// GitHub API docs: https://docs.github.com/rest/git/commits#get-a-commit-object
+// GitHub API docs: https://docs.github.com/rest/git/tags#get-a-tag
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-tags
+//
+//meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+//meta:operation GET /repos/{owner}/{repo}/git/tags/{tag_sha}
+//meta:operation GET /repos/{owner}/{repo}/tags
+func (s *RepositoriesService) ListTagsWithDates(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryTagWithDate, *Response, error) {
+	tags, resp, err := s.ListTags(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		errResp  *Response
+	)
+	result := make([]*RepositoryTagWithDate, len(tags))
+	sem := make(chan struct{}, maxConcurrentTagDateRequests)
+	for i, tag := range tags {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			date, r, err := s.tagCommitDate(ctx, owner, repo, tag.GetCommit().GetSHA())
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr, errResp = err, r
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			result[i] = &RepositoryTagWithDate{Tag: tag, CommittedAt: date}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, errResp, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// tagCommitDate returns the committer date of the commit sha refers to. If
+// sha is not a commit, it is looked up as an annotated tag object and the
+// commit the tag points to is used instead.
+func (s *RepositoriesService) tagCommitDate(ctx context.Context, owner, repo, sha string) (time.Time, *Response, error) {
+	commit, resp, err := s.client.Git.GetCommit(ctx, owner, repo, sha)
+	if err != nil {
+		if resp == nil || (resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusUnprocessableEntity) {
+			return time.Time{}, resp, err
+		}
+
+		tag, tagResp, tagErr := s.client.Git.GetTag(ctx, owner, repo, sha)
+		if tagErr != nil {
+			// sha is neither a commit nor a tag; report the original error.
+			return time.Time{}, resp, err
+		}
+		if tag.GetObject().GetType() != "commit" {
+			return time.Time{}, tagResp, fmt.Errorf("tag %v does not point to a commit", tag.GetTag())
+		}
+
+		commit, resp, err = s.client.Git.GetCommit(ctx, owner, repo, tag.GetObject().GetSHA())
+		if err != nil {
+			return time.Time{}, resp, err
+		}
+	}
+
+	return commit.GetCommitter().GetDate().Time, resp, nil
+}
+
 // Branch represents a repository branch.
 type Branch struct {
 	Name      *string           `json:"name,omitempty"`
@@ -1385,6 +1672,118 @@ type DismissalRestrictionsRequest struct {
 	Apps *[]string `json:"apps,omitempty"`
 }
 
+// ToRequest converts p, as returned by GetBranchProtection, into a
+// ProtectionRequest that UpdateBranchProtection accepts, so that callers can
+// read the protection of a branch, modify it and write it back. Users, teams
+// and apps are converted to their logins and slugs. Status checks are sent
+// in the checks form when p has any, and as contexts otherwise.
+//
+// Required signatures are not part of ProtectionRequest and are managed with
+// RequireSignaturesOnProtectedBranch and OptionalSignaturesOnProtectedBranch.
+func (p *Protection) ToRequest() *ProtectionRequest {
+	if p == nil {
+		return nil
+	}
+
+	req := new(ProtectionRequest)
+
+	if sc := p.RequiredStatusChecks; sc != nil {
+		req.RequiredStatusChecks = &RequiredStatusChecks{Strict: sc.Strict}
+		if sc.Checks != nil {
+			req.RequiredStatusChecks.Checks = sc.Checks
+		} else {
+			req.RequiredStatusChecks.Contexts = sc.Contexts
+		}
+	}
+
+	if r := p.RequiredPullRequestReviews; r != nil {
+		req.RequiredPullRequestReviews = &PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          r.DismissStaleReviews,
+			RequireCodeOwnerReviews:      r.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: r.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      Ptr(r.RequireLastPushApproval),
+		}
+		if b := r.BypassPullRequestAllowances; b != nil {
+			req.RequiredPullRequestReviews.BypassPullRequestAllowancesRequest = &BypassPullRequestAllowancesRequest{
+				Users: userLogins(b.Users),
+				Teams: teamSlugs(b.Teams),
+				Apps:  appSlugs(b.Apps),
+			}
+		}
+		if d := r.DismissalRestrictions; d != nil {
+			users, teams, apps := userLogins(d.Users), teamSlugs(d.Teams), appSlugs(d.Apps)
+			req.RequiredPullRequestReviews.DismissalRestrictionsRequest = &DismissalRestrictionsRequest{
+				Users: &users,
+				Teams: &teams,
+				Apps:  &apps,
+			}
+		}
+	}
+
+	if p.EnforceAdmins != nil {
+		req.EnforceAdmins = p.EnforceAdmins.Enabled
+	}
+
+	if r := p.Restrictions; r != nil {
+		req.Restrictions = &BranchRestrictionsRequest{
+			Users: userLogins(r.Users),
+			Teams: teamSlugs(r.Teams),
+			Apps:  appSlugs(r.Apps),
+		}
+	}
+
+	if p.RequireLinearHistory != nil {
+		req.RequireLinearHistory = Ptr(p.RequireLinearHistory.Enabled)
+	}
+	if p.AllowForcePushes != nil {
+		req.AllowForcePushes = Ptr(p.AllowForcePushes.Enabled)
+	}
+	if p.AllowDeletions != nil {
+		req.AllowDeletions = Ptr(p.AllowDeletions.Enabled)
+	}
+	if p.RequiredConversationResolution != nil {
+		req.RequiredConversationResolution = Ptr(p.RequiredConversationResolution.Enabled)
+	}
+	if p.BlockCreations != nil {
+		req.BlockCreations = p.BlockCreations.Enabled
+	}
+	if p.LockBranch != nil {
+		req.LockBranch = p.LockBranch.Enabled
+	}
+	if p.AllowForkSyncing != nil {
+		req.AllowForkSyncing = p.AllowForkSyncing.Enabled
+	}
+
+	return req
+}
+
+// userLogins returns the logins of users. The result is never nil.
+func userLogins(users []*User) []string {
+	logins := make([]string, 0, len(users))
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+	return logins
+}
+
+// teamSlugs returns the slugs of teams. The result is never nil.
+func teamSlugs(teams []*Team) []string {
+	slugs := make([]string, 0, len(teams))
+	for _, t := range teams {
+		slugs = append(slugs, t.GetSlug())
+	}
+	return slugs
+}
+
+// appSlugs returns the slugs of apps. The result is never nil.
+func appSlugs(apps []*App) []string {
+	slugs := make([]string, 0, len(apps))
+	for _, a := range apps {
+		slugs = append(slugs, a.GetSlug())
+	}
+	return slugs
+}
+
 // SignaturesProtectedBranch represents the protection status of an individual branch.
 type SignaturesProtectedBranch struct {
 	URL *string `json:"url,omitempty"`
@@ -1481,6 +1880,16 @@ func (s *RepositoriesService) RenameBranch(ctx context.Context, owner, repo, bra
 	return b, resp, nil
 }
 
+// SetDefaultBranch sets the default branch of a repository. The branch must
+// already exist; to rename the default branch instead, use RenameBranch.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#update-a-repository
+//
+//meta:operation PATCH /repos/{owner}/{repo}
+func (s *RepositoriesService) SetDefaultBranch(ctx context.Context, owner, repo, branch string) (*Repository, *Response, error) {
+	return s.Edit(ctx, owner, repo, &Repository{DefaultBranch: Ptr(branch)})
+}
+
 // GetBranchProtection gets the protection of a given branch.
 //
 // Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
@@ -1561,6 +1970,53 @@ func (s *RepositoriesService) ListRequiredStatusChecksContexts(ctx context.Conte
 	return contexts, resp, nil
 }
 
+// AddRequiredStatusChecksContexts adds contexts to the required status checks
+// of a given protected branch, leaving the other protection settings
+// unchanged. It returns the resulting list of contexts.
+//
+// Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branch-protection#add-status-check-contexts
+//
+//meta:operation POST /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts
+func (s *RepositoriesService) AddRequiredStatusChecksContexts(ctx context.Context, owner, repo, branch string, contexts []string) ([]string, *Response, error) {
+	return s.editRequiredStatusChecksContexts(ctx, "POST", owner, repo, branch, contexts)
+}
+
+// RemoveRequiredStatusChecksContexts removes contexts from the required status
+// checks of a given protected branch, leaving the other protection settings
+// unchanged. It returns the remaining list of contexts.
+//
+// Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branch-protection#remove-status-check-contexts
+//
+//meta:operation DELETE /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts
+func (s *RepositoriesService) RemoveRequiredStatusChecksContexts(ctx context.Context, owner, repo, branch string, contexts []string) ([]string, *Response, error) {
+	return s.editRequiredStatusChecksContexts(ctx, "DELETE", owner, repo, branch, contexts)
+}
+
+func (s *RepositoriesService) editRequiredStatusChecksContexts(ctx context.Context, method, owner, repo, branch string, contexts []string) ([]string, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks/contexts", owner, repo, url.PathEscape(branch))
+	req, err := s.client.NewRequest(method, u, &struct {
+		Contexts []string `json:"contexts"`
+	}{Contexts: contexts})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result []string
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		if isBranchNotProtected(err) {
+			err = ErrBranchNotProtected
+		}
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
 // UpdateBranchProtection updates the protection of a given branch.
 //
 // Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
@@ -1738,6 +2194,53 @@ func (s *RepositoriesService) License(ctx context.Context, owner, repo string) (
 	return r, resp, nil
 }
 
+// GetLicense gets a repository's license if one is detected. Unlike License,
+// the returned Content is decoded and Encoding is cleared.
+//
+// GitHub API docs: https://docs.github.com/rest/licenses/licenses#get-the-license-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/license
+func (s *RepositoriesService) GetLicense(ctx context.Context, owner, repo string) (*RepositoryLicense, *Response, error) {
+	return s.GetLicenseForRef(ctx, owner, repo, "")
+}
+
+// GetLicenseForRef gets a repository's license as of ref, which can be a
+// branch, tag or commit SHA. If ref is empty, the default branch is used.
+// The returned Content is decoded and Encoding is cleared.
+//
+// GitHub API docs: https://docs.github.com/rest/licenses/licenses#get-the-license-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/license
+func (s *RepositoriesService) GetLicenseForRef(ctx context.Context, owner, repo, ref string) (*RepositoryLicense, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/license", owner, repo)
+	u, err := addOptions(u, &RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &RepositoryLicense{}
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	content, err := (&RepositoryContent{Encoding: r.Encoding, Content: r.Content}).GetContent()
+	if err != nil {
+		return nil, resp, err
+	}
+	if r.Content != nil {
+		r.Content = &content
+	}
+	r.Encoding = nil
+
+	return r, resp, nil
+}
+
 // GetPullRequestReviewEnforcement gets pull request review enforcement of a protected branch.
 //
 // Note: the branch name is URL path escaped for you. See: https://pkg.go.dev/net/url#PathEscape .
@@ -1921,9 +2424,6 @@ func (s *RepositoriesService) ListAllTopics(ctx context.Context, owner, repo str
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeTopicsPreview)
-
 	topics := new(repositoryTopics)
 	resp, err := s.client.Do(ctx, req, topics)
 	if err != nil {
@@ -1951,9 +2451,6 @@ func (s *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner, repo
 		return nil, nil, err
 	}
 
-	// TODO: remove custom Accept header when this API fully launches.
-	req.Header.Set("Accept", mediaTypeTopicsPreview)
-
 	t = new(repositoryTopics)
 	resp, err := s.client.Do(ctx, req, t)
 	if err != nil {
@@ -1963,6 +2460,62 @@ func (s *RepositoriesService) ReplaceAllTopics(ctx context.Context, owner, repo
 	return t.Names, resp, nil
 }
 
+// AddTopics adds topics to a repository, preserving its existing topics.
+// Topics that are already present are ignored, and if there is nothing to add
+// the topics are not replaced.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-all-repository-topics
+// GitHub API docs: https://docs.github.com/rest/repos/repos#replace-all-repository-topics
+//
+//meta:operation GET /repos/{owner}/{repo}/topics
+//meta:operation PUT /repos/{owner}/{repo}/topics
+func (s *RepositoriesService) AddTopics(ctx context.Context, owner, repo string, topics ...string) ([]string, *Response, error) {
+	existing, resp, err := s.ListAllTopics(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	names := existing
+	for _, topic := range topics {
+		if !slices.Contains(names, topic) {
+			names = append(names, topic)
+		}
+	}
+	if len(names) == len(existing) {
+		return existing, resp, nil
+	}
+
+	return s.ReplaceAllTopics(ctx, owner, repo, names)
+}
+
+// RemoveTopics removes topics from a repository, preserving its other topics.
+// Topics that are not present are ignored, and if there is nothing to remove
+// the topics are not replaced.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-all-repository-topics
+// GitHub API docs: https://docs.github.com/rest/repos/repos#replace-all-repository-topics
+//
+//meta:operation GET /repos/{owner}/{repo}/topics
+//meta:operation PUT /repos/{owner}/{repo}/topics
+func (s *RepositoriesService) RemoveTopics(ctx context.Context, owner, repo string, topics ...string) ([]string, *Response, error) {
+	existing, resp, err := s.ListAllTopics(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	names := make([]string, 0, len(existing))
+	for _, name := range existing {
+		if !slices.Contains(topics, name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == len(existing) {
+		return existing, resp, nil
+	}
+
+	return s.ReplaceAllTopics(ctx, owner, repo, names)
+}
+
 // ListApps lists the GitHub apps that have push access to a given protected branch.
 // It requires the GitHub apps to have `write` access to the `content` permission.
 //
@@ -2330,12 +2883,32 @@ type DispatchRequestOptions struct {
 	ClientPayload *json.RawMessage `json:"client_payload,omitempty"`
 }
 
+// maxDispatchEventTypeLength is the maximum number of characters GitHub
+// accepts in the event_type of a repository_dispatch event.
+const maxDispatchEventTypeLength = 100
+
+// validateDispatchEventType checks that eventType is accepted by GitHub as
+// the event_type of a repository_dispatch event.
+func validateDispatchEventType(eventType string) error {
+	if eventType == "" {
+		return errors.New("event type is required for a repository dispatch event")
+	}
+	if n := utf8.RuneCountInString(eventType); n > maxDispatchEventTypeLength {
+		return fmt.Errorf("event type must be %v characters or fewer, got %v", maxDispatchEventTypeLength, n)
+	}
+	return nil
+}
+
 // Dispatch triggers a repository_dispatch event in a GitHub Actions workflow.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/repos#create-a-repository-dispatch-event
 //
 //meta:operation POST /repos/{owner}/{repo}/dispatches
 func (s *RepositoriesService) Dispatch(ctx context.Context, owner, repo string, opts DispatchRequestOptions) (*Repository, *Response, error) {
+	if err := validateDispatchEventType(opts.EventType); err != nil {
+		return nil, nil, fmt.Errorf("validation failed: %w", err)
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/dispatches", owner, repo)
 
 	req, err := s.client.NewRequest("POST", u, &opts)
@@ -2352,6 +2925,27 @@ func (s *RepositoriesService) Dispatch(ctx context.Context, owner, repo string,
 	return r, resp, nil
 }
 
+// DispatchTyped triggers a repository_dispatch event in a GitHub Actions
+// workflow with payload marshaled as its client_payload. It is a typed
+// alternative to RepositoriesService.Dispatch, which takes a raw JSON payload.
+//
+// It is a function rather than a method because Go methods cannot have type
+// parameters.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#create-a-repository-dispatch-event
+func DispatchTyped[T any](ctx context.Context, s *RepositoriesService, owner, repo, eventType string, payload T) (*Repository, *Response, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	clientPayload := json.RawMessage(b)
+
+	return s.Dispatch(ctx, owner, repo, DispatchRequestOptions{
+		EventType:     eventType,
+		ClientPayload: &clientPayload,
+	})
+}
+
 // isBranchNotProtected determines whether a branch is not protected
 // based on the error message returned by GitHub API.
 func isBranchNotProtected(err error) bool {
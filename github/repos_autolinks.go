@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // AutolinkOptions specifies parameters for RepositoriesService.AddAutolink method.
@@ -54,11 +56,17 @@ func (s *RepositoriesService) ListAutolinks(ctx context.Context, owner, repo str
 
 // AddAutolink creates an autolink reference for a repository.
 // Users with admin access to the repository can create an autolink.
+// The URLTemplate of opts must contain the <num> placeholder for the
+// reference number.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/autolinks#create-an-autolink-reference-for-a-repository
 //
 //meta:operation POST /repos/{owner}/{repo}/autolinks
 func (s *RepositoriesService) AddAutolink(ctx context.Context, owner, repo string, opts *AutolinkOptions) (*Autolink, *Response, error) {
+	if !strings.Contains(opts.GetURLTemplate(), "<num>") {
+		return nil, nil, errors.New("autolink URL template must contain the <num> placeholder")
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/autolinks", owner, repo)
 	req, err := s.client.NewRequest("POST", u, opts)
 	if err != nil {
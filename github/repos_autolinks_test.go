@@ -113,6 +113,26 @@ func TestRepositoriesService_AddAutolink(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_AddAutolink_invalidURLTemplate(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent for an invalid URL template")
+	})
+
+	ctx := context.Background()
+	for _, opts := range []*AutolinkOptions{
+		nil,
+		{KeyPrefix: Ptr("TICKET-")},
+		{KeyPrefix: Ptr("TICKET-"), URLTemplate: Ptr("https://example.com/TICKET?query=")},
+	} {
+		if _, _, err := client.Repositories.AddAutolink(ctx, "o", "r", opts); err == nil {
+			t.Errorf("Repositories.AddAutolink(%+v) returned nil error, want error", opts)
+		}
+	}
+}
+
 func TestRepositoriesService_GetAutolink(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
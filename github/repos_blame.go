@@ -0,0 +1,89 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// BlameRange represents a range of consecutive lines of a file that were
+// last changed by the same commit.
+type BlameRange struct {
+	// StartingLine and EndingLine are the 1-based, inclusive bounds of the range.
+	StartingLine *int `json:"startingLine,omitempty"`
+	EndingLine   *int `json:"endingLine,omitempty"`
+	// Age is the recency of the change, from 1 (newest) to 10 (oldest).
+	Age    *int         `json:"age,omitempty"`
+	Commit *BlameCommit `json:"commit,omitempty"`
+}
+
+// BlameCommit represents the commit that last changed a BlameRange.
+type BlameCommit struct {
+	SHA           *string       `json:"oid,omitempty"`
+	Author        *CommitAuthor `json:"author,omitempty"`
+	CommittedDate *Timestamp    `json:"committedDate,omitempty"`
+}
+
+const blameQuery = `query($owner: String!, $repo: String!, $ref: String!, $path: String!) {
+  repository(owner: $owner, name: $repo) {
+    object(expression: $ref) {
+      ... on Commit {
+        blame(path: $path) {
+          ranges {
+            startingLine
+            endingLine
+            age
+            commit { oid committedDate author { name email date } }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// GetBlame returns the blame of the file at path as of ref, which may be a
+// branch, tag or commit SHA. An empty ref means the default branch.
+//
+// There is no REST endpoint for blame, so it is fetched with the GraphQL API
+// and the token used must be allowed to access it. GitHub returns all the
+// ranges of a file at once; the blame is not paginated. See
+// https://docs.github.com/graphql/reference/objects#blame
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *RepositoriesService) GetBlame(ctx context.Context, owner, repo, path, ref string) ([]*BlameRange, *Response, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	vars := map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+		"ref":   ref,
+		"path":  path,
+	}
+
+	var data struct {
+		Repository *struct {
+			Object *struct {
+				Blame *struct {
+					Ranges []*BlameRange `json:"ranges"`
+				} `json:"blame"`
+			} `json:"object"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.doGraphQL(ctx, blameQuery, vars, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if data.Repository == nil || data.Repository.Object == nil || data.Repository.Object.Blame == nil {
+		return nil, resp, fmt.Errorf("no commit found for ref %q", ref)
+	}
+
+	return data.Repository.Object.Blame.Ranges, resp, nil
+}
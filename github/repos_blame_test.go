@@ -0,0 +1,96 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_GetBlame(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body graphQLRequest
+		assertNilError(t, json.NewDecoder(r.Body).Decode(&body))
+		wantVars := map[string]interface{}{"owner": "o", "repo": "r", "ref": "main", "path": "a/b.go"}
+		if !cmp.Equal(body.Variables, wantVars) {
+			t.Errorf("Request variables = %+v, want %+v", body.Variables, wantVars)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"object":{"blame":{"ranges":[
+			{"startingLine":1,"endingLine":3,"age":1,"commit":{"oid":"s1","committedDate":"2024-01-02T03:04:05Z","author":{"name":"n1","email":"e1"}}},
+			{"startingLine":4,"endingLine":4,"age":10,"commit":{"oid":"s2","committedDate":"2020-01-02T03:04:05Z","author":{"name":"n2","email":"e2"}}}
+		]}}}}}`)
+	})
+
+	ctx := context.Background()
+	ranges, _, err := client.Repositories.GetBlame(ctx, "o", "r", "a/b.go", "main")
+	if err != nil {
+		t.Errorf("Repositories.GetBlame returned error: %v", err)
+	}
+
+	want := []*BlameRange{
+		{
+			StartingLine: Ptr(1),
+			EndingLine:   Ptr(3),
+			Age:          Ptr(1),
+			Commit: &BlameCommit{
+				SHA:           Ptr("s1"),
+				Author:        &CommitAuthor{Name: Ptr("n1"), Email: Ptr("e1")},
+				CommittedDate: &Timestamp{time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)},
+			},
+		},
+		{
+			StartingLine: Ptr(4),
+			EndingLine:   Ptr(4),
+			Age:          Ptr(10),
+			Commit: &BlameCommit{
+				SHA:           Ptr("s2"),
+				Author:        &CommitAuthor{Name: Ptr("n2"), Email: Ptr("e2")},
+				CommittedDate: &Timestamp{time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)},
+			},
+		},
+	}
+	if !cmp.Equal(ranges, want) {
+		t.Errorf("Repositories.GetBlame returned %+v, want %+v", ranges, want)
+	}
+
+	const methodName = "GetBlame"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetBlame(ctx, "o", "r", "a/b.go", "main")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetBlame_refNotFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data":{"repository":{"object":null}}}`)
+	})
+
+	ctx := context.Background()
+	ranges, _, err := client.Repositories.GetBlame(ctx, "o", "r", "a/b.go", "")
+	if err == nil {
+		t.Error("Repositories.GetBlame returned nil error, want an error")
+	}
+	if ranges != nil {
+		t.Errorf("Repositories.GetBlame returned %+v, want nil", ranges)
+	}
+}
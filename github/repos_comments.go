@@ -7,9 +7,16 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
+// ErrLineNotInDiff is returned by RepositoriesService.CreateCommitCommentAtLine
+// when the line is not part of the commit's diff.
+var ErrLineNotInDiff = errors.New("line is not part of the diff")
+
 // RepositoryComment represents a comment for a commit, file, or line in a repository.
 type RepositoryComment struct {
 	HTMLURL   *string    `json:"html_url,omitempty"`
@@ -33,6 +40,22 @@ func (r RepositoryComment) String() string {
 	return Stringify(r)
 }
 
+// TotalReactions returns the total number of reactions to the comment. It
+// uses the total count reported by GitHub, or else the sum of the per-reaction
+// counts. It returns 0 if r or its reactions are nil.
+func (r *RepositoryComment) TotalReactions() int {
+	reactions := r.GetReactions()
+	if reactions == nil {
+		return 0
+	}
+	if reactions.TotalCount != nil {
+		return *reactions.TotalCount
+	}
+	return reactions.GetPlusOne() + reactions.GetMinusOne() + reactions.GetLaugh() +
+		reactions.GetConfused() + reactions.GetHeart() + reactions.GetHooray() +
+		reactions.GetRocket() + reactions.GetEyes()
+}
+
 // ListComments lists all the comments for the repository.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments-for-a-repository
@@ -62,6 +85,29 @@ func (s *RepositoriesService) ListComments(ctx context.Context, owner, repo stri
 	return comments, resp, nil
 }
 
+// ListCommentsWithReactions lists all the comments for the repository like
+// ListComments, and guarantees that the Reactions of every returned comment
+// is non-nil. Comments GitHub returned without a reaction summary get an
+// empty one.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/comments
+func (s *RepositoriesService) ListCommentsWithReactions(ctx context.Context, owner, repo string, opts *ListOptions) ([]*RepositoryComment, *Response, error) {
+	comments, resp, err := s.ListComments(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, c := range comments {
+		if c.Reactions == nil {
+			c.Reactions = &Reactions{}
+		}
+	}
+
+	return comments, resp, nil
+}
+
 // ListCommitComments lists all the comments for a given commit SHA.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments
@@ -113,6 +159,87 @@ func (s *RepositoriesService) CreateComment(ctx context.Context, owner, repo, sh
 	return c, resp, nil
 }
 
+// CreateCommitCommentAtLine creates a comment on line of the file at path,
+// as of the given commit. It fetches the commit's diff to compute the diff
+// position that CreateComment expects. The line must be an added or context
+// line of the diff, otherwise ErrLineNotInDiff is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#create-a-commit-comment
+// GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
+//
+//meta:operation POST /repos/{owner}/{repo}/commits/{commit_sha}/comments
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}
+func (s *RepositoriesService) CreateCommitCommentAtLine(ctx context.Context, owner, repo, sha, path string, line int, body string) (*RepositoryComment, *Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		commit, resp, err := s.GetCommit(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, f := range commit.Files {
+			if f.GetFilename() != path {
+				continue
+			}
+			position, ok := diffPosition(f.GetPatch(), line)
+			if !ok {
+				return nil, resp, ErrLineNotInDiff
+			}
+			comment := &RepositoryComment{
+				Body:     Ptr(body),
+				Path:     Ptr(path),
+				Position: Ptr(position),
+			}
+			return s.CreateComment(ctx, owner, repo, sha, comment)
+		}
+
+		if resp.NextPage == 0 {
+			return nil, resp, ErrLineNotInDiff
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// diffPosition returns the position in patch, the unified diff of a single
+// file, of line in the new version of the file. The position is the number of
+// lines below the first hunk header; it keeps counting through later hunk
+// headers. It reports false if line is not an added or context line.
+func diffPosition(patch string, line int) (int, bool) {
+	if patch == "" {
+		return 0, false
+	}
+
+	var newLine int
+	for position, l := range strings.Split(patch, "\n") {
+		switch {
+		case strings.HasPrefix(l, "@@"):
+			// @@ -oldStart[,oldLines] +newStart[,newLines] @@ [section]
+			fields := strings.Fields(l)
+			if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+				return 0, false
+			}
+			start, _, _ := strings.Cut(strings.TrimPrefix(fields[2], "+"), ",")
+			n, err := strconv.Atoi(start)
+			if err != nil {
+				return 0, false
+			}
+			newLine = n
+		case position == 0:
+			// A patch starts with a hunk header.
+			return 0, false
+		case strings.HasPrefix(l, "-"), strings.HasPrefix(l, "\\"):
+			// Removed lines and "\ No newline at end of file" markers
+			// are not part of the new file.
+		default:
+			if newLine == line {
+				return position, true
+			}
+			newLine++
+		}
+	}
+	return 0, false
+}
+
 // GetComment gets a single comment from a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/comments#get-a-commit-comment
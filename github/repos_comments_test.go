@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -62,6 +63,69 @@ func TestRepositoriesService_ListComments_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_ListCommentsWithReactions(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"reactions":{"total_count":3,"+1":2,"heart":1}}, {"id":2}]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	comments, _, err := client.Repositories.ListCommentsWithReactions(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListCommentsWithReactions returned error: %v", err)
+	}
+
+	want := []*RepositoryComment{
+		{ID: Ptr(int64(1)), Reactions: &Reactions{TotalCount: Ptr(3), PlusOne: Ptr(2), Heart: Ptr(1)}},
+		{ID: Ptr(int64(2)), Reactions: &Reactions{}},
+	}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("Repositories.ListCommentsWithReactions returned %+v, want %+v", comments, want)
+	}
+
+	const methodName = "ListCommentsWithReactions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListCommentsWithReactions(ctx, "\n", "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListCommentsWithReactions(ctx, "o", "r", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoryComment_TotalReactions(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		comment *RepositoryComment
+		want    int
+	}{
+		"nil comment":  {nil, 0},
+		"no reactions": {&RepositoryComment{}, 0},
+		"total count":  {&RepositoryComment{Reactions: &Reactions{TotalCount: Ptr(5), PlusOne: Ptr(1)}}, 5},
+		"summed":       {&RepositoryComment{Reactions: &Reactions{PlusOne: Ptr(2), Eyes: Ptr(1), Rocket: Ptr(3)}}, 6},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.comment.TotalReactions(); got != tc.want {
+				t.Errorf("TotalReactions() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestRepositoriesService_ListCommitComments(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -162,6 +226,105 @@ func TestRepositoriesService_CreateComment_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_CreateCommitCommentAtLine(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	// Lines of the patch by position:
+	// 1: package main      (new line 1)
+	// 2: -import "os"
+	// 3: +import "fmt"     (new line 2)
+	// 4: @@ -10,2 +10,3 @@
+	// 5:  func main() {    (new line 10)
+	// 6: +	fmt.Println()   (new line 11)
+	// 7:  }                (new line 12)
+	patch := "@@ -1,2 +1,2 @@\n package main\n-import \"os\"\n+import \"fmt\"\n@@ -10,2 +10,3 @@\n func main() {\n+\tfmt.Println()\n }"
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		b, err := json.Marshal(patch)
+		assertNilError(t, err)
+		fmt.Fprintf(w, `{"sha":"s","files":[{"filename":"other.go","patch":"@@ -1 +1 @@\n-a\n+b"},{"filename":"main.go","patch":%s}]}`, b)
+	})
+
+	var gotComments []*RepositoryComment
+	mux.HandleFunc("/repos/o/r/commits/s/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(RepositoryComment)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		gotComments = append(gotComments, v)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	for _, line := range []int{2, 11, 12} {
+		comment, _, err := client.Repositories.CreateCommitCommentAtLine(ctx, "o", "r", "s", "main.go", line, "b")
+		if err != nil {
+			t.Fatalf("Repositories.CreateCommitCommentAtLine(line %v) returned error: %v", line, err)
+		}
+		if want := (&RepositoryComment{ID: Ptr(int64(1))}); !cmp.Equal(comment, want) {
+			t.Errorf("Repositories.CreateCommitCommentAtLine returned %+v, want %+v", comment, want)
+		}
+	}
+
+	want := []*RepositoryComment{
+		{Body: Ptr("b"), Path: Ptr("main.go"), Position: Ptr(3)},
+		{Body: Ptr("b"), Path: Ptr("main.go"), Position: Ptr(6)},
+		{Body: Ptr("b"), Path: Ptr("main.go"), Position: Ptr(7)},
+	}
+	if !cmp.Equal(gotComments, want) {
+		t.Errorf("Request bodies = %+v, want %+v", gotComments, want)
+	}
+
+	for _, tt := range []struct {
+		path string
+		line int
+	}{
+		{"main.go", 5},
+		{"main.go", 13},
+		{"missing.go", 1},
+	} {
+		_, _, err := client.Repositories.CreateCommitCommentAtLine(ctx, "o", "r", "s", tt.path, tt.line, "b")
+		if !errors.Is(err, ErrLineNotInDiff) {
+			t.Errorf("Repositories.CreateCommitCommentAtLine(%v, %v) returned error %v, want %v", tt.path, tt.line, err, ErrLineNotInDiff)
+		}
+	}
+
+	const methodName = "CreateCommitCommentAtLine"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreateCommitCommentAtLine(ctx, "\n", "\n", "\n", "main.go", 2, "b")
+		return err
+	})
+}
+
+func TestDiffPosition(t *testing.T) {
+	t.Parallel()
+	patch := "@@ -1,3 +1,3 @@\n a\n-b\n+c\n d\n\\ No newline at end of file"
+	tests := []struct {
+		line         int
+		wantPosition int
+		wantOK       bool
+	}{
+		{line: 1, wantPosition: 1, wantOK: true},
+		{line: 2, wantPosition: 3, wantOK: true},
+		{line: 3, wantPosition: 4, wantOK: true},
+		{line: 4},
+	}
+
+	for _, tt := range tests {
+		position, ok := diffPosition(patch, tt.line)
+		if position != tt.wantPosition || ok != tt.wantOK {
+			t.Errorf("diffPosition(line %v) = %v, %v, want %v, %v", tt.line, position, ok, tt.wantPosition, tt.wantOK)
+		}
+	}
+
+	for _, patch := range []string{"", "not a patch", "@@ bad @@\n a"} {
+		if _, ok := diffPosition(patch, 1); ok {
+			t.Errorf("diffPosition(%q, 1) reported ok, want not ok", patch)
+		}
+	}
+}
+
 func TestRepositoriesService_GetComment(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
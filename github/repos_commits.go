@@ -174,6 +174,25 @@ func (s *RepositoriesService) GetCommit(ctx context.Context, owner, repo, sha st
 	return commit, resp, nil
 }
 
+// GetCommitStats fetches the specified commit without its list of changed
+// files, which can be very large. The returned commit has Stats populated
+// and Files always nil. To page through the files of a large commit, use
+// GetCommit with ListOptions instead.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}
+func (s *RepositoriesService) GetCommitStats(ctx context.Context, owner, repo, sha string) (*RepositoryCommit, *Response, error) {
+	// The files array cannot be omitted, so request the smallest page of it.
+	commit, resp, err := s.GetCommit(ctx, owner, repo, sha, &ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	commit.Files = nil
+	return commit, resp, nil
+}
+
 // GetCommitRaw fetches the specified commit in raw (diff or patch) format.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
@@ -232,6 +251,37 @@ func (s *RepositoriesService) GetCommitSHA1(ctx context.Context, owner, repo, re
 	return buf.String(), resp, nil
 }
 
+// FindMergedPRForCommit finds the merged pull request that introduced the
+// commit with the given SHA. If several merged pull requests are associated
+// with the commit, the one whose merge commit is sha is preferred. The boolean
+// result reports whether such a pull request was found.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#list-pull-requests-associated-with-a-commit
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/pulls
+func (s *RepositoriesService) FindMergedPRForCommit(ctx context.Context, owner, repo, sha string) (*PullRequest, bool, *Response, error) {
+	var found *PullRequest
+	opts := &ListOptions{PerPage: 100}
+	for {
+		pulls, resp, err := s.client.PullRequests.ListPullRequestsWithCommitByState(ctx, owner, repo, sha, "merged", opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		for _, pull := range pulls {
+			if pull.GetMergeCommitSHA() == sha {
+				return pull, true, resp, nil
+			}
+			if found == nil {
+				found = pull
+			}
+		}
+		if resp.NextPage == 0 {
+			return found, found != nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // CompareCommits compares a range of commits with each other.
 //
 // GitHub API docs: https://docs.github.com/rest/commits/commits#compare-two-commits
@@ -261,6 +311,18 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	return comp, resp, nil
 }
 
+// CompareCommitsAcrossForks compares base in the specified repository with
+// headRef in the fork of it owned by headOwner, using the
+// "base...headOwner:headRef" syntax. Both repositories must be in the same
+// network.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#compare-two-commits
+//
+//meta:operation GET /repos/{owner}/{repo}/compare/{basehead}
+func (s *RepositoriesService) CompareCommitsAcrossForks(ctx context.Context, owner, repo, base, headOwner, headRef string, opts *ListOptions) (*CommitsComparison, *Response, error) {
+	return s.CompareCommits(ctx, owner, repo, base, headOwner+":"+headRef, opts)
+}
+
 // CompareCommitsRaw compares a range of commits with each other in raw (diff or patch) format.
 //
 // Both "base" and "head" must be branch names in "repo".
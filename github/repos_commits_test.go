@@ -159,6 +159,53 @@ func TestRepositoriesService_GetCommit(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetCommitStats(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{
+			"sha": "s",
+			"stats": {"additions": 104, "deletions": 4, "total": 108},
+			"files": [{"filename": "f"}]
+		}`)
+	})
+
+	ctx := context.Background()
+	commit, _, err := client.Repositories.GetCommitStats(ctx, "o", "r", "s")
+	if err != nil {
+		t.Errorf("Repositories.GetCommitStats returned error: %v", err)
+	}
+
+	want := &RepositoryCommit{
+		SHA: Ptr("s"),
+		Stats: &CommitStats{
+			Additions: Ptr(104),
+			Deletions: Ptr(4),
+			Total:     Ptr(108),
+		},
+	}
+	if !cmp.Equal(commit, want) {
+		t.Errorf("Repositories.GetCommitStats returned %+v, want %+v", commit, want)
+	}
+
+	const methodName = "GetCommitStats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetCommitStats(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetCommitStats(ctx, "o", "r", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_GetCommitRaw_diff(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -372,6 +419,65 @@ func TestRepositoriesService_TrailingPercent_GetCommitSHA1(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_FindMergedPRForCommit(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/s/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[
+			{"number":1,"state":"open"},
+			{"number":2,"state":"closed","merged_at":"2006-01-02T15:04:05Z","merge_commit_sha":"m"}
+		]`)
+	})
+
+	ctx := context.Background()
+	pull, ok, _, err := client.Repositories.FindMergedPRForCommit(ctx, "o", "r", "s")
+	if err != nil {
+		t.Errorf("Repositories.FindMergedPRForCommit returned error: %v", err)
+	}
+	if !ok {
+		t.Error("Repositories.FindMergedPRForCommit returned ok = false, want true")
+	}
+	if got, want := pull.GetNumber(), 2; got != want {
+		t.Errorf("Repositories.FindMergedPRForCommit returned pull request #%v, want #%v", got, want)
+	}
+
+	const methodName = "FindMergedPRForCommit"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.Repositories.FindMergedPRForCommit(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, _, resp, err := client.Repositories.FindMergedPRForCommit(ctx, "o", "r", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_FindMergedPRForCommit_notFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/s/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"number":1,"state":"open"}]`)
+	})
+
+	ctx := context.Background()
+	pull, ok, _, err := client.Repositories.FindMergedPRForCommit(ctx, "o", "r", "s")
+	if err != nil {
+		t.Errorf("Repositories.FindMergedPRForCommit returned error: %v", err)
+	}
+	if ok || pull != nil {
+		t.Errorf("Repositories.FindMergedPRForCommit returned %+v, %v, want nil, false", pull, ok)
+	}
+}
+
 func TestRepositoriesService_CompareCommits(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -513,6 +619,45 @@ func TestRepositoriesService_CompareCommits(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CompareCommitsAcrossForks(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/compare/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/repos/o/r/compare/main...u%3Afeature%2Fx"; got != want {
+			t.Errorf("request path = %v, want %v", got, want)
+		}
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"status":"ahead","ahead_by":1}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.CompareCommitsAcrossForks(ctx, "o", "r", "main", "u", "feature/x", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsAcrossForks returned error: %v", err)
+	}
+
+	want := &CommitsComparison{Status: Ptr("ahead"), AheadBy: Ptr(1)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.CompareCommitsAcrossForks returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "CompareCommitsAcrossForks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CompareCommitsAcrossForks(ctx, "\n", "\n", "main", "u", "feature/x", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CompareCommitsAcrossForks(ctx, "o", "r", "main", "u", "feature/x", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_CompareCommitsRaw_diff(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -687,6 +832,30 @@ func TestRepositoriesService_ListBranchesHeadCommit(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListBranchesHeadCommit_sharedHead(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/commits/s/branches-where-head", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"main","commit":{"sha":"s"},"protected":true},{"name":"release","commit":{"sha":"s"},"protected":false}]`)
+	})
+
+	ctx := context.Background()
+	branches, _, err := client.Repositories.ListBranchesHeadCommit(ctx, "o", "r", "s")
+	if err != nil {
+		t.Errorf("Repositories.ListBranchesHeadCommit returned error: %v", err)
+	}
+
+	want := []*BranchCommit{
+		{Name: Ptr("main"), Commit: &Commit{SHA: Ptr("s")}, Protected: Ptr(true)},
+		{Name: Ptr("release"), Commit: &Commit{SHA: Ptr("s")}, Protected: Ptr(false)},
+	}
+	if !cmp.Equal(branches, want) {
+		t.Errorf("Repositories.ListBranchesHeadCommit returned %+v, want %+v", branches, want)
+	}
+}
+
 func TestBranchCommit_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &BranchCommit{}, "{}")
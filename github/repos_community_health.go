@@ -41,7 +41,7 @@ type CommunityHealthMetrics struct {
 	ContentReportsEnabled *bool                 `json:"content_reports_enabled"`
 }
 
-// GetCommunityHealthMetrics retrieves all the community health  metrics for a  repository.
+// GetCommunityHealthMetrics retrieves all the community health metrics for a repository.
 //
 // GitHub API docs: https://docs.github.com/rest/metrics/community#get-community-profile-metrics
 //
@@ -72,8 +72,27 @@ func (r RepositoryContent) String() string {
 	return Stringify(r)
 }
 
+// IsSymlink reports whether r is a symbolic link. The link target is
+// available from GetTarget.
+func (r *RepositoryContent) IsSymlink() bool {
+	return r.GetType() == "symlink"
+}
+
+// IsSubmodule reports whether r is a git submodule. The submodule's
+// repository URL is available from GetSubmoduleGitURL.
+func (r *RepositoryContent) IsSubmodule() bool {
+	return r.GetType() == "submodule"
+}
+
 // GetContent returns the content of r, decoding it if necessary.
+// Directories, symbolic links and submodules have no content, so an empty
+// string is returned for them.
 func (r *RepositoryContent) GetContent() (string, error) {
+	switch r.GetType() {
+	case "dir", "symlink", "submodule":
+		return "", nil
+	}
+
 	var encoding string
 	if r.Encoding != nil {
 		encoding = *r.Encoding
@@ -208,6 +227,71 @@ func (s *RepositoriesService) DownloadContentsWithMeta(ctx context.Context, owne
 	return nil, nil, resp, fmt.Errorf("no file named %s found in %s", filename, dir)
 }
 
+// ConditionalFileContent is the result of
+// RepositoriesService.GetFileContentConditional.
+type ConditionalFileContent struct {
+	// SHA is the blob SHA of the file at the requested ref.
+	SHA string
+	// NotModified is true when SHA matches the known SHA passed to
+	// GetFileContentConditional. Content is nil in that case.
+	NotModified bool
+	// Content holds the raw bytes of the file when it has changed.
+	Content []byte
+}
+
+// GetFileContentConditional fetches the raw content of the file at filepath
+// and ref, unless its blob SHA equals knownSHA. The SHA is looked up from the
+// metadata of the parent directory first, so unchanged files are never
+// downloaded. An empty ref selects the repository's default branch and an
+// empty knownSHA always downloads the file.
+//
+// Like DownloadContents, this works with files larger than the 1 MB limit of
+// GetContents.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#get-a-blob
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-repository-content
+//
+//meta:operation GET /repos/{owner}/{repo}/contents/{path}
+//meta:operation GET /repos/{owner}/{repo}/git/blobs/{file_sha}
+func (s *RepositoriesService) GetFileContentConditional(ctx context.Context, owner, repo, filepath, ref, knownSHA string) (*ConditionalFileContent, *Response, error) {
+	var opts *RepositoryContentGetOptions
+	if ref != "" {
+		opts = &RepositoryContentGetOptions{Ref: ref}
+	}
+
+	dir := path.Dir(filepath)
+	filename := path.Base(filepath)
+	_, dirContents, resp, err := s.GetContents(ctx, owner, repo, dir, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, contents := range dirContents {
+		if contents.GetName() != filename {
+			continue
+		}
+		if contents.GetType() != "file" {
+			return nil, resp, fmt.Errorf("%s is not a file", filepath)
+		}
+
+		result := &ConditionalFileContent{SHA: contents.GetSHA()}
+		if knownSHA != "" && result.SHA == knownSHA {
+			result.NotModified = true
+			return result, resp, nil
+		}
+
+		content, resp, err := s.client.Git.GetBlobRaw(ctx, owner, repo, result.SHA)
+		if err != nil {
+			return nil, resp, err
+		}
+		result.Content = content
+
+		return result, resp, nil
+	}
+
+	return nil, resp, fmt.Errorf("no file named %s found in %s", filename, dir)
+}
+
 // GetContents can return either the metadata and content of a single file
 // (when path references a file) or the metadata of all the files and/or
 // subdirectories of a directory (when path references a directory). To make it
@@ -323,6 +407,127 @@ func (s *RepositoriesService) DeleteFile(ctx context.Context, owner, repo, path
 	return deleteResponse, resp, nil
 }
 
+// DeleteFilesOptions specifies parameters to the RepositoriesService.DeleteFiles method.
+type DeleteFilesOptions struct {
+	// Paths lists the files to delete. (Required.)
+	Paths []string
+	// Message is the commit message. (Required.)
+	Message string
+	// Branch is the branch to commit to. Defaults to the repository's default branch.
+	Branch string
+	// Author and Committer of the commit. Both default to the authenticated user.
+	Author    *CommitAuthor
+	Committer *CommitAuthor
+}
+
+// DeleteFilesResponse represents the result of the RepositoriesService.DeleteFiles method.
+type DeleteFilesResponse struct {
+	// Commit is the commit deleting the files. It is nil if none of the paths existed.
+	Commit *Commit `json:"commit,omitempty"`
+	// Deleted lists the paths that were deleted.
+	Deleted []string `json:"deleted,omitempty"`
+	// Skipped lists the paths that were not deleted because no file exists there.
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// DeleteFiles deletes several files from a repository in a single commit,
+// using the Git Data API. Paths that do not point to a file on the branch are
+// skipped and reported in DeleteFilesResponse.Skipped rather than failing.
+//
+// GitHub API docs: https://docs.github.com/rest/git/commits#create-a-commit
+// GitHub API docs: https://docs.github.com/rest/git/commits#get-a-commit-object
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/trees#create-a-tree
+// GitHub API docs: https://docs.github.com/rest/git/trees#get-a-tree
+// GitHub API docs: https://docs.github.com/rest/repos/repos#get-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}
+//meta:operation POST /repos/{owner}/{repo}/git/commits
+//meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+//meta:operation POST /repos/{owner}/{repo}/git/trees
+//meta:operation GET /repos/{owner}/{repo}/git/trees/{tree_sha}
+func (s *RepositoriesService) DeleteFiles(ctx context.Context, owner, repo string, opts *DeleteFilesOptions) (*DeleteFilesResponse, *Response, error) {
+	if opts == nil || len(opts.Paths) == 0 {
+		return nil, nil, errors.New("paths must be provided")
+	}
+	if opts.Message == "" {
+		return nil, nil, errors.New("commit message must be provided")
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		r, resp, err := s.Get(ctx, owner, repo)
+		if err != nil {
+			return nil, resp, err
+		}
+		branch = r.GetDefaultBranch()
+	}
+
+	ref, resp, err := s.client.Git.GetRef(ctx, owner, repo, "heads/"+branch)
+	if err != nil {
+		return nil, resp, err
+	}
+	parent, resp, err := s.client.Git.GetCommit(ctx, owner, repo, ref.GetObject().GetSHA())
+	if err != nil {
+		return nil, resp, err
+	}
+	tree, resp, err := s.client.Git.GetTree(ctx, owner, repo, parent.GetTree().GetSHA(), true)
+	if err != nil {
+		return nil, resp, err
+	}
+	if tree.GetTruncated() {
+		return nil, resp, errors.New("repository tree is too large to be listed in full")
+	}
+
+	files := make(map[string]*TreeEntry)
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" {
+			files[entry.GetPath()] = entry
+		}
+	}
+
+	result := &DeleteFilesResponse{}
+	var entries []*TreeEntry
+	for _, p := range opts.Paths {
+		file, ok := files[p]
+		if !ok {
+			result.Skipped = append(result.Skipped, p)
+			continue
+		}
+		// An entry without SHA and Content deletes the file.
+		entries = append(entries, &TreeEntry{Path: file.Path, Mode: file.Mode, Type: file.Type})
+		result.Deleted = append(result.Deleted, p)
+	}
+	if len(entries) == 0 {
+		return result, resp, nil
+	}
+
+	newTree, resp, err := s.client.Git.CreateTree(ctx, owner, repo, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return nil, resp, err
+	}
+	commit, resp, err := s.client.Git.CreateCommit(ctx, owner, repo, &Commit{
+		Message:   &opts.Message,
+		Tree:      &Tree{SHA: newTree.SHA},
+		Parents:   []*Commit{{SHA: parent.SHA}},
+		Author:    opts.Author,
+		Committer: opts.Committer,
+	}, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	newRef := &Reference{Ref: Ptr("refs/heads/" + branch), Object: &GitObject{SHA: commit.SHA}}
+	if _, resp, err = s.client.Git.UpdateRef(ctx, owner, repo, newRef, false); err != nil {
+		return nil, resp, err
+	}
+
+	result.Commit = commit
+	return result, resp, nil
+}
+
 // ArchiveFormat is used to define the archive type when calling GetArchiveLink.
 type ArchiveFormat string
 
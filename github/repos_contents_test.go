@@ -79,6 +79,42 @@ func TestRepositoryContent_GetContent(t *testing.T) {
 	}
 }
 
+func TestRepositoryContent_nonFileTypes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		typ           string
+		wantSymlink   bool
+		wantSubmodule bool
+	}{
+		{typ: "dir"},
+		{typ: "symlink", wantSymlink: true},
+		{typ: "submodule", wantSubmodule: true},
+	}
+
+	for _, tt := range tests {
+		// Content that is not valid base64 must not be decoded.
+		r := &RepositoryContent{Type: Ptr(tt.typ), Encoding: Ptr("base64"), Content: Ptr("not base64!")}
+		got, err := r.GetContent()
+		if err != nil {
+			t.Errorf("GetContent for type %q returned error: %v", tt.typ, err)
+		}
+		if got != "" {
+			t.Errorf("GetContent for type %q returned %q, want empty", tt.typ, got)
+		}
+		if r.IsSymlink() != tt.wantSymlink {
+			t.Errorf("IsSymlink for type %q = %v, want %v", tt.typ, r.IsSymlink(), tt.wantSymlink)
+		}
+		if r.IsSubmodule() != tt.wantSubmodule {
+			t.Errorf("IsSubmodule for type %q = %v, want %v", tt.typ, r.IsSubmodule(), tt.wantSubmodule)
+		}
+	}
+
+	var r *RepositoryContent
+	if r.IsSymlink() || r.IsSubmodule() {
+		t.Error("nil RepositoryContent reported as symlink or submodule")
+	}
+}
+
 // stringOrNil converts a potentially null string pointer to string.
 // For non-nil input pointer, the returned string is enclosed in double-quotes.
 func stringOrNil(s *string) string {
@@ -414,6 +450,79 @@ func TestRepositoriesService_DownloadContentsWithMeta_NoFile(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetFileContentConditional(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var blobRequests int
+	mux.HandleFunc("/repos/o/r/contents/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprint(w, `[{"type": "file", "name": "f", "sha": "s2"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs/s2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+		blobRequests++
+		fmt.Fprint(w, "foo")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetFileContentConditional(ctx, "o", "r", "d/f", "main", "s2")
+	if err != nil {
+		t.Fatalf("Repositories.GetFileContentConditional returned error: %v", err)
+	}
+	want := &ConditionalFileContent{SHA: "s2", NotModified: true}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetFileContentConditional returned %+v, want %+v", got, want)
+	}
+	if blobRequests != 0 {
+		t.Errorf("Repositories.GetFileContentConditional downloaded unchanged file %v times", blobRequests)
+	}
+
+	got, _, err = client.Repositories.GetFileContentConditional(ctx, "o", "r", "d/f", "main", "s1")
+	if err != nil {
+		t.Fatalf("Repositories.GetFileContentConditional returned error: %v", err)
+	}
+	want = &ConditionalFileContent{SHA: "s2", Content: []byte("foo")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetFileContentConditional returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetFileContentConditional"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetFileContentConditional(ctx, "\n", "\n", "\n", "", "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetFileContentConditional(ctx, "o", "r", "d/f", "main", "s1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetFileContentConditional_notFile(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contents/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"type": "dir", "name": "f", "sha": "s1"}]`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.GetFileContentConditional(ctx, "o", "r", "d/f", "", "")
+	if err == nil {
+		t.Error("Repositories.GetFileContentConditional did not return expected error")
+	}
+	if resp == nil {
+		t.Error("Repositories.GetFileContentConditional did not return expected response")
+	}
+}
+
 func TestRepositoriesService_GetContents_File(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -453,6 +562,67 @@ func TestRepositoriesService_GetContents_File(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetContents_Symlink(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contents/bin/link", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "type": "symlink",
+		  "target": "/usr/local/bin/tool",
+		  "size": 19,
+		  "name": "link",
+		  "path": "bin/link"
+		}`)
+	})
+	ctx := context.Background()
+	fileContents, _, _, err := client.Repositories.GetContents(ctx, "o", "r", "bin/link", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	if !fileContents.IsSymlink() {
+		t.Errorf("Repositories.GetContents returned type %q, want symlink", fileContents.GetType())
+	}
+	if got, want := fileContents.GetTarget(), "/usr/local/bin/tool"; got != want {
+		t.Errorf("Repositories.GetContents returned target %q, want %q", got, want)
+	}
+	if got, err := fileContents.GetContent(); got != "" || err != nil {
+		t.Errorf("GetContent returned %q, %v, want empty, nil", got, err)
+	}
+}
+
+func TestRepositoriesService_GetContents_Submodule(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contents/vendor/lib", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+		  "type": "submodule",
+		  "submodule_git_url": "git://github.com/o/lib.git",
+		  "size": 0,
+		  "name": "lib",
+		  "path": "vendor/lib",
+		  "sha": "fa1b2c"
+		}`)
+	})
+	ctx := context.Background()
+	fileContents, _, _, err := client.Repositories.GetContents(ctx, "o", "r", "vendor/lib", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	if !fileContents.IsSubmodule() {
+		t.Errorf("Repositories.GetContents returned type %q, want submodule", fileContents.GetType())
+	}
+	if got, want := fileContents.GetSubmoduleGitURL(), "git://github.com/o/lib.git"; got != want {
+		t.Errorf("Repositories.GetContents returned submodule URL %q, want %q", got, want)
+	}
+	if got, err := fileContents.GetContent(); got != "" || err != nil {
+		t.Errorf("GetContent returned %q, %v, want empty, nil", got, err)
+	}
+}
+
 func TestRepositoriesService_GetContents_FilenameNeedsEscape(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -705,6 +875,132 @@ func TestRepositoriesService_DeleteFile(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_DeleteFiles(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"type":"commit","sha":"c1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/c1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"c1","tree":{"sha":"t1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		fmt.Fprint(w, `{
+			"sha": "t1",
+			"tree": [
+				{"path": "a.txt", "mode": "100644", "type": "blob", "sha": "b1"},
+				{"path": "dir", "mode": "040000", "type": "tree", "sha": "t2"},
+				{"path": "dir/b.sh", "mode": "100755", "type": "blob", "sha": "b2"},
+				{"path": "keep.txt", "mode": "100644", "type": "blob", "sha": "b3"}
+			],
+			"truncated": false
+		}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"base_tree":"t1","tree":[{"sha":null,"path":"a.txt","mode":"100644","type":"blob"},{"sha":null,"path":"dir/b.sh","mode":"100755","type":"blob"}]}`+"\n")
+		fmt.Fprint(w, `{"sha":"t3"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"author":{"name":"n","email":"e"},"message":"cleanup","tree":"t3","parents":["c1"]}`+"\n")
+		fmt.Fprint(w, `{"sha":"c2"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"c2","force":false}`+"\n")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"type":"commit","sha":"c2"}}`)
+	})
+
+	opts := &DeleteFilesOptions{
+		Paths:   []string{"a.txt", "missing.txt", "dir/b.sh", "dir"},
+		Message: "cleanup",
+		Author:  &CommitAuthor{Name: Ptr("n"), Email: Ptr("e")},
+	}
+	ctx := context.Background()
+	got, _, err := client.Repositories.DeleteFiles(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Repositories.DeleteFiles returned error: %v", err)
+	}
+
+	want := &DeleteFilesResponse{
+		Commit:  &Commit{SHA: Ptr("c2")},
+		Deleted: []string{"a.txt", "dir/b.sh"},
+		Skipped: []string{"missing.txt", "dir"},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.DeleteFiles returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "DeleteFiles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.DeleteFiles(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.DeleteFiles(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_DeleteFiles_nothingToDelete(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/dev", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/dev","object":{"type":"commit","sha":"c1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/c1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"c1","tree":{"sha":"t1"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"t1","tree":[{"path":"keep.txt","mode":"100644","type":"blob","sha":"b3"}]}`)
+	})
+
+	opts := &DeleteFilesOptions{Paths: []string{"missing.txt"}, Message: "cleanup", Branch: "dev"}
+	ctx := context.Background()
+	got, _, err := client.Repositories.DeleteFiles(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Repositories.DeleteFiles returned error: %v", err)
+	}
+
+	want := &DeleteFilesResponse{Skipped: []string{"missing.txt"}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.DeleteFiles returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_DeleteFiles_invalidOptions(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, opts := range []*DeleteFilesOptions{
+		nil,
+		{Message: "m"},
+		{Paths: []string{"a.txt"}},
+	} {
+		if _, _, err := client.Repositories.DeleteFiles(ctx, "o", "r", opts); err == nil {
+			t.Errorf("Repositories.DeleteFiles(%+v) returned nil error, want error", opts)
+		}
+	}
+}
 func TestRepositoriesService_GetArchiveLink(t *testing.T) {
 	t.Parallel()
 	tcs := []struct {
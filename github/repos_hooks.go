@@ -77,12 +77,16 @@ type createHookRequest struct {
 // Config is a required field.
 //
 // Note that only a subset of the hook fields are used and hook must
-// not be nil.
+// not be nil. If set, hook.Config.ContentType must be "json" or "form".
 //
 // GitHub API docs: https://docs.github.com/rest/repos/webhooks#create-a-repository-webhook
 //
 //meta:operation POST /repos/{owner}/{repo}/hooks
 func (s *RepositoriesService) CreateHook(ctx context.Context, owner, repo string, hook *Hook) (*Hook, *Response, error) {
+	if err := hook.Config.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/hooks", owner, repo)
 
 	hookReq := &createHookRequest{
@@ -25,6 +25,20 @@ type HookConfig struct {
 	Secret *string `json:"secret,omitempty"`
 }
 
+// validate reports whether c can be sent to GitHub. The returned error never
+// includes the value of Secret.
+func (c *HookConfig) validate() error {
+	if c == nil || c.ContentType == nil {
+		return nil
+	}
+	switch ct := *c.ContentType; ct {
+	case "json", "form":
+		return nil
+	default:
+		return fmt.Errorf("invalid webhook content type %q: must be \"json\" or \"form\"", ct)
+	}
+}
+
 // GetHookConfiguration returns the configuration for the specified repository webhook.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/webhooks#get-a-webhook-configuration-for-a-repository
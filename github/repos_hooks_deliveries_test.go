@@ -54,6 +54,44 @@ func TestRepositoriesService_ListHookDeliveries(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListHookDeliveries_cursorPaging(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("cursor") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/hooks/1/deliveries?per_page=2&cursor=v1_2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
+		case "v1_2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.FormValue("cursor"))
+		}
+	})
+
+	ctx := context.Background()
+	opts := &ListCursorOptions{PerPage: 2}
+	var got []*HookDelivery
+	for {
+		deliveries, resp, err := client.Repositories.ListHookDeliveries(ctx, "o", "r", 1, opts)
+		if err != nil {
+			t.Fatalf("Repositories.ListHookDeliveries returned error: %v", err)
+		}
+		got = append(got, deliveries...)
+		if resp.Cursor == "" {
+			break
+		}
+		opts.Cursor = resp.Cursor
+	}
+
+	want := []*HookDelivery{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}, {ID: Ptr(int64(3))}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListHookDeliveries returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_ListHookDeliveries_invalidOwner(t *testing.T) {
 	t.Parallel()
 	client, _, _ := setup(t)
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -60,6 +61,70 @@ func TestRepositoriesService_CreateHook(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateHook_config(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &Hook{
+		Events: []string{"push"},
+		Config: &HookConfig{
+			ContentType: Ptr("json"),
+			InsecureSSL: Ptr("0"),
+			URL:         Ptr("https://example.com/webhook"),
+			Secret:      Ptr("s3cr3t"),
+		},
+	}
+
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"web","config":{"content_type":"json","insecure_ssl":"0","url":"https://example.com/webhook","secret":"s3cr3t"},"events":["push"]}`+"\n")
+		fmt.Fprint(w, `{"id":1,"config":{"content_type":"json","url":"https://example.com/webhook","secret":"********"}}`)
+	})
+
+	ctx := context.Background()
+	hook, _, err := client.Repositories.CreateHook(ctx, "o", "r", input)
+	if err != nil {
+		t.Fatalf("Repositories.CreateHook returned error: %v", err)
+	}
+
+	want := &Hook{
+		ID: Ptr(int64(1)),
+		Config: &HookConfig{
+			ContentType: Ptr("json"),
+			URL:         Ptr("https://example.com/webhook"),
+			Secret:      Ptr("********"),
+		},
+	}
+	if !cmp.Equal(hook, want) {
+		t.Errorf("Repositories.CreateHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestRepositoriesService_CreateHook_invalidContentType(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	input := &Hook{
+		Config: &HookConfig{
+			ContentType: Ptr("xml"),
+			URL:         Ptr("https://example.com/webhook"),
+			Secret:      Ptr("s3cr3t"),
+		},
+	}
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.CreateHook(ctx, "o", "r", input)
+	if err == nil {
+		t.Fatal("Repositories.CreateHook returned nil error, want error")
+	}
+	if resp != nil {
+		t.Errorf("Repositories.CreateHook returned response %+v, want nil", resp)
+	}
+	if strings.Contains(err.Error(), "s3cr3t") {
+		t.Errorf("Repositories.CreateHook error %q contains the webhook secret", err)
+	}
+}
+
 func TestRepositoriesService_ListHooks(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -71,12 +71,19 @@ func (s *RepositoriesService) DeleteInvitation(ctx context.Context, owner, repo
 // invitation.
 //
 // permissions represents the permissions that the associated user will have
-// on the repository. Possible values are: "read", "write", "admin".
+// on the repository. Possible values are: "read", "write", "maintain",
+// "triage", "admin". Any other value is rejected without calling the API.
 //
 // GitHub API docs: https://docs.github.com/rest/collaborators/invitations#update-a-repository-invitation
 //
 //meta:operation PATCH /repos/{owner}/{repo}/invitations/{invitation_id}
 func (s *RepositoriesService) UpdateInvitation(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*RepositoryInvitation, *Response, error) {
+	switch permissions {
+	case "read", "write", "maintain", "triage", "admin":
+	default:
+		return nil, nil, fmt.Errorf("invalid invitation permissions %q", permissions)
+	}
+
 	opts := &struct {
 		Permissions string `json:"permissions"`
 	}{Permissions: permissions}
@@ -83,6 +83,7 @@ func TestRepositoriesService_UpdateInvitation(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/invitations/2", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"permissions":"write"}`+"\n")
 		fmt.Fprintf(w, `{"id":1}`)
 	})
 
@@ -112,6 +113,17 @@ func TestRepositoriesService_UpdateInvitation(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_UpdateInvitation_invalidPermissions(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.UpdateInvitation(ctx, "o", "r", 2, "pull")
+	if err == nil {
+		t.Error("Repositories.UpdateInvitation returned nil error, want an error")
+	}
+}
+
 func TestRepositoryInvitation_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &RepositoryInvitation{}, "{}")
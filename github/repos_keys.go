@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // The Key type is defined in users_keys.go
@@ -38,6 +39,73 @@ func (s *RepositoriesService) ListKeys(ctx context.Context, owner string, repo s
 	return keys, resp, nil
 }
 
+// ListAllKeys lists all the deploy keys for a repository, following
+// pagination.
+//
+// GitHub API docs: https://docs.github.com/rest/deploy-keys/deploy-keys#list-deploy-keys
+//
+//meta:operation GET /repos/{owner}/{repo}/keys
+func (s *RepositoriesService) ListAllKeys(ctx context.Context, owner, repo string) ([]*Key, *Response, error) {
+	var (
+		all  []*Key
+		resp *Response
+	)
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, r, err := s.ListKeys(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, r, err
+		}
+		resp = r
+		all = append(all, keys...)
+
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// EnsureKey adds key as a deploy key of a repository unless the same public
+// key is already present. Keys are compared by type and key material, so
+// differing titles or trailing comments do not matter. It returns the
+// existing or newly created key and whether it was created.
+//
+// GitHub API docs: https://docs.github.com/rest/deploy-keys/deploy-keys#create-a-deploy-key
+// GitHub API docs: https://docs.github.com/rest/deploy-keys/deploy-keys#list-deploy-keys
+//
+//meta:operation GET /repos/{owner}/{repo}/keys
+//meta:operation POST /repos/{owner}/{repo}/keys
+func (s *RepositoriesService) EnsureKey(ctx context.Context, owner, repo string, key *Key) (*Key, bool, *Response, error) {
+	keys, resp, err := s.ListAllKeys(ctx, owner, repo)
+	if err != nil {
+		return nil, false, resp, err
+	}
+
+	for _, k := range keys {
+		if sameSSHKey(k.GetKey(), key.GetKey()) {
+			return k, false, resp, nil
+		}
+	}
+
+	created, resp, err := s.CreateKey(ctx, owner, repo, key)
+	if err != nil {
+		return nil, false, resp, err
+	}
+
+	return created, true, resp, nil
+}
+
+// sameSSHKey reports whether a and b, in authorized_keys format, hold the
+// same public key, ignoring any comment.
+func sameSSHKey(a, b string) bool {
+	fa, fb := strings.Fields(a), strings.Fields(b)
+	if len(fa) < 2 || len(fb) < 2 {
+		return false
+	}
+	return fa[0] == fb[0] && fa[1] == fb[1]
+}
+
 // GetKey fetches a single deploy key.
 //
 // GitHub API docs: https://docs.github.com/rest/deploy-keys/deploy-keys#get-a-deploy-key
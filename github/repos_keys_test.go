@@ -61,6 +61,84 @@ func TestRepositoriesService_ListKeys_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_ListAllKeys(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/keys?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	keys, _, err := client.Repositories.ListAllKeys(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.ListAllKeys returned error: %v", err)
+	}
+
+	want := []*Key{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
+	if !cmp.Equal(keys, want) {
+		t.Errorf("Repositories.ListAllKeys returned %+v, want %+v", keys, want)
+	}
+
+	const methodName = "ListAllKeys"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListAllKeys(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_EnsureKey(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var created bool
+	mux.HandleFunc("/repos/o/r/keys", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[{"id":1,"key":"ssh-ed25519 AAAA","title":"old"}]`)
+		case "POST":
+			created = true
+			testBody(t, r, `{"key":"ssh-ed25519 BBBB","title":"new"}`+"\n")
+			fmt.Fprint(w, `{"id":2,"key":"ssh-ed25519 BBBB","title":"new"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	key, ok, _, err := client.Repositories.EnsureKey(ctx, "o", "r", &Key{Key: Ptr("ssh-ed25519 AAAA user@host"), Title: Ptr("t")})
+	if err != nil {
+		t.Errorf("Repositories.EnsureKey returned error: %v", err)
+	}
+	if ok || created {
+		t.Error("Repositories.EnsureKey created a key that already exists")
+	}
+	if got, want := key.GetID(), int64(1); got != want {
+		t.Errorf("Repositories.EnsureKey returned key %v, want %v", got, want)
+	}
+
+	key, ok, _, err = client.Repositories.EnsureKey(ctx, "o", "r", &Key{Key: Ptr("ssh-ed25519 BBBB"), Title: Ptr("new")})
+	if err != nil {
+		t.Errorf("Repositories.EnsureKey returned error: %v", err)
+	}
+	if !ok || !created {
+		t.Error("Repositories.EnsureKey did not create a missing key")
+	}
+	if got, want := key.GetID(), int64(2); got != want {
+		t.Errorf("Repositories.EnsureKey returned key %v, want %v", got, want)
+	}
+}
+
 func TestRepositoriesService_GetKey(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
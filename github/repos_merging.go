@@ -7,9 +7,16 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+// ErrMergeConflict is returned by MergeBranch when the head cannot be merged
+// into the base because of a conflict, in which case GitHub responds with
+// 409 Conflict.
+var ErrMergeConflict = errors.New("merge conflict")
+
 // RepositoryMergeRequest represents a request to merge a branch in a
 // repository.
 type RepositoryMergeRequest struct {
@@ -53,6 +60,33 @@ func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, req
 	return commit, resp, nil
 }
 
+// MergeBranch merges request.Head into request.Base in the specified repository.
+//
+// It returns the merge commit if one was created, or a nil commit if the base
+// already contains the head and there is nothing to merge. If the merge fails
+// because of a conflict, the returned error wraps both ErrMergeConflict and
+// the *ErrorResponse holding GitHub's message.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branches#merge-a-branch
+//
+//meta:operation POST /repos/{owner}/{repo}/merges
+func (s *RepositoriesService) MergeBranch(ctx context.Context, owner, repo string, request *RepositoryMergeRequest) (*RepositoryCommit, *Response, error) {
+	commit, resp, err := s.Merge(ctx, owner, repo, request)
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusConflict:
+			if err != nil {
+				err = fmt.Errorf("%w: %w", ErrMergeConflict, err)
+			}
+			return nil, resp, err
+		case http.StatusNoContent:
+			return nil, resp, err
+		}
+	}
+
+	return commit, resp, err
+}
+
 // MergeUpstream syncs a branch of a forked repository to keep it up-to-date
 // with the upstream repository.
 //
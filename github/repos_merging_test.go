@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -63,6 +64,104 @@ func TestRepositoriesService_Merge(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_MergeBranch(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &RepositoryMergeRequest{
+		Base:          Ptr("b"),
+		Head:          Ptr("h"),
+		CommitMessage: Ptr("c"),
+	}
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"base":"b","head":"h","commit_message":"c"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"sha":"s"}`)
+	})
+
+	ctx := context.Background()
+	commit, resp, err := client.Repositories.MergeBranch(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.MergeBranch returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Repositories.MergeBranch returned status %v, want %v", resp.StatusCode, http.StatusCreated)
+	}
+
+	want := &RepositoryCommit{SHA: Ptr("s")}
+	if !cmp.Equal(commit, want) {
+		t.Errorf("Repositories.MergeBranch returned %+v, want %+v", commit, want)
+	}
+
+	const methodName = "MergeBranch"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.MergeBranch(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.MergeBranch(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_MergeBranch_nothingToMerge(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	commit, resp, err := client.Repositories.MergeBranch(ctx, "o", "r", &RepositoryMergeRequest{Base: Ptr("b"), Head: Ptr("h")})
+	if err != nil {
+		t.Errorf("Repositories.MergeBranch returned error: %v", err)
+	}
+	if commit != nil {
+		t.Errorf("Repositories.MergeBranch returned %+v, want nil", commit)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Repositories.MergeBranch returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestRepositoriesService_MergeBranch_conflict(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Merge Conflict"}`)
+	})
+
+	ctx := context.Background()
+	commit, resp, err := client.Repositories.MergeBranch(ctx, "o", "r", &RepositoryMergeRequest{Base: Ptr("b"), Head: Ptr("h")})
+	if !errors.Is(err, ErrMergeConflict) {
+		t.Errorf("Repositories.MergeBranch returned error %v, want %v", err, ErrMergeConflict)
+	}
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("Repositories.MergeBranch returned error %v, want %v", err, ErrConflict)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "Merge Conflict" {
+		t.Errorf("Repositories.MergeBranch returned error %v, want an *ErrorResponse with message %q", err, "Merge Conflict")
+	}
+	if commit != nil {
+		t.Errorf("Repositories.MergeBranch returned %+v, want nil", commit)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Repositories.MergeBranch returned status %v, want %v", resp.StatusCode, http.StatusConflict)
+	}
+}
+
 func TestRepositoryMergeRequest_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &RepositoryMergeRequest{}, "{}")
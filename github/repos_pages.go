@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 )
 
 // Pages represents a GitHub Pages site configuration.
@@ -203,6 +205,17 @@ func (s *RepositoriesService) UpdatePagesGHES(ctx context.Context, owner, repo s
 	return resp, nil
 }
 
+// EnablePagesHTTPS enforces HTTPS for the GitHub Pages site of the named repo.
+// Only the HTTPS setting is sent, so the custom domain and the other settings
+// of the site are left unchanged.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#update-information-about-a-github-pages-site
+//
+//meta:operation PUT /repos/{owner}/{repo}/pages
+func (s *RepositoriesService) EnablePagesHTTPS(ctx context.Context, owner, repo string) (*Response, error) {
+	return s.UpdatePagesGHES(ctx, owner, repo, &PagesUpdateWithoutCNAME{HTTPSEnforced: Ptr(true)})
+}
+
 // DisablePages disables GitHub Pages for the named repo.
 //
 // GitHub API docs: https://docs.github.com/rest/pages/pages#delete-a-github-pages-site
@@ -331,6 +344,66 @@ func (s *RepositoriesService) RequestPageBuild(ctx context.Context, owner, repo
 	return build, resp, nil
 }
 
+// WaitForPagesBuild requests a build of a GitHub Pages site and polls the
+// latest build every interval until it is a different build from the latest
+// one seen before the request and its status is "built" or "errored". The
+// first poll happens after interval has elapsed, to give GitHub time to start
+// the requested build. If interval is not positive, one second is used.
+//
+// The final build is returned. If the build errored, the error message
+// reported by GitHub is returned as an error along with the build. Polling
+// stops with ctx.Err() when ctx is done.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#get-latest-pages-build
+// GitHub API docs: https://docs.github.com/rest/pages/pages#request-a-github-pages-build
+//
+//meta:operation POST /repos/{owner}/{repo}/pages/builds
+//meta:operation GET /repos/{owner}/{repo}/pages/builds/latest
+func (s *RepositoriesService) WaitForPagesBuild(ctx context.Context, owner, repo string, interval time.Duration) (*PagesBuild, *Response, error) {
+	if interval <= 0 {
+		interval = defaultPollDelay
+	}
+
+	// Right after the request, the latest build is often still the previous
+	// one, so remember it to avoid returning it as the requested build. A
+	// site that has never been built has no latest build.
+	previous, resp, err := s.GetLatestPagesBuild(ctx, owner, repo)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, resp, err
+	}
+
+	_, resp, err = s.RequestPageBuild(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		case <-timer.C:
+		}
+
+		build, latestResp, err := s.GetLatestPagesBuild(ctx, owner, repo)
+		if err != nil {
+			return nil, latestResp, err
+		}
+		resp = latestResp
+
+		if previous == nil || build.GetURL() != previous.GetURL() {
+			switch build.GetStatus() {
+			case "built":
+				return build, resp, nil
+			case "errored":
+				return build, resp, fmt.Errorf("pages build errored: %v", build.GetError().GetMessage())
+			}
+		}
+		timer.Reset(interval)
+	}
+}
+
 // GetPageHealthCheck gets a DNS health check for the CNAME record configured for a repository's GitHub Pages.
 //
 // GitHub API docs: https://docs.github.com/rest/pages/pages#get-a-dns-health-check-for-github-pages
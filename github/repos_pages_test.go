@@ -9,10 +9,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -238,6 +240,54 @@ func TestRepositoriesService_UpdatePagesGHES(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_UpdatePagesGHES_disableHTTPS(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &PagesUpdateWithoutCNAME{
+		HTTPSEnforced: Ptr(false),
+	}
+
+	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"https_enforced":false}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.UpdatePagesGHES(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdatePagesGHES returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_EnablePagesHTTPS(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"https_enforced":true}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.EnablePagesHTTPS(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.EnablePagesHTTPS returned error: %v", err)
+	}
+
+	const methodName = "EnablePagesHTTPS"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.EnablePagesHTTPS(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.EnablePagesHTTPS(ctx, "o", "r")
+	})
+}
+
 func TestRepositoriesService_UpdatePages_NullCNAME(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -487,6 +537,168 @@ func TestRepositoriesService_RequestPageBuild(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_WaitForPagesBuild(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var requested bool
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		requested = true
+		fmt.Fprint(w, `{"url":"u","status":"queued"}`)
+	})
+	var polls int
+	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if !requested {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"url":"b2","status":"building"}`)
+			return
+		}
+		fmt.Fprint(w, `{"url":"b2","status":"built","commit":"c"}`)
+	})
+
+	ctx := context.Background()
+	build, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", time.Millisecond)
+	if err != nil {
+		t.Errorf("Repositories.WaitForPagesBuild returned error: %v", err)
+	}
+
+	want := &PagesBuild{URL: Ptr("b2"), Status: Ptr("built"), Commit: Ptr("c")}
+	if !cmp.Equal(build, want) {
+		t.Errorf("Repositories.WaitForPagesBuild returned %+v, want %+v", build, want)
+	}
+	if polls != 2 {
+		t.Errorf("Repositories.WaitForPagesBuild polled %v times, want 2", polls)
+	}
+
+	const methodName = "WaitForPagesBuild"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.WaitForPagesBuild(ctx, "\n", "\n", time.Millisecond)
+		return err
+	})
+}
+
+func TestRepositoriesService_WaitForPagesBuild_previousBuild(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var requested bool
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		requested = true
+		fmt.Fprint(w, `{"url":"u","status":"queued"}`)
+	})
+	var polls int
+	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if !requested {
+			fmt.Fprint(w, `{"url":"b1","status":"built","commit":"old"}`)
+			return
+		}
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"url":"b1","status":"built","commit":"old"}`)
+			return
+		}
+		fmt.Fprint(w, `{"url":"b2","status":"built","commit":"new"}`)
+	})
+
+	ctx := context.Background()
+	build, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", time.Millisecond)
+	if err != nil {
+		t.Errorf("Repositories.WaitForPagesBuild returned error: %v", err)
+	}
+
+	want := &PagesBuild{URL: Ptr("b2"), Status: Ptr("built"), Commit: Ptr("new")}
+	if !cmp.Equal(build, want) {
+		t.Errorf("Repositories.WaitForPagesBuild returned %+v, want %+v", build, want)
+	}
+	if polls != 2 {
+		t.Errorf("Repositories.WaitForPagesBuild polled %v times, want 2", polls)
+	}
+}
+
+func TestRepositoriesService_WaitForPagesBuild_latestError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("build requested after getting the previous build failed")
+	})
+	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", time.Millisecond)
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Repositories.WaitForPagesBuild returned error %v, want %v", err, ErrForbidden)
+	}
+}
+
+func TestRepositoriesService_WaitForPagesBuild_errored(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var requested bool
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		requested = true
+		fmt.Fprint(w, `{"status":"queued"}`)
+	})
+	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if !requested {
+			fmt.Fprint(w, `{"url":"b1","status":"built"}`)
+			return
+		}
+		fmt.Fprint(w, `{"url":"b2","status":"errored","error":{"message":"bad config"}}`)
+	})
+
+	ctx := context.Background()
+	build, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", time.Millisecond)
+	if err == nil {
+		t.Fatal("Repositories.WaitForPagesBuild returned nil error, want an error")
+	}
+	if got, want := err.Error(), "pages build errored: bad config"; got != want {
+		t.Errorf("Repositories.WaitForPagesBuild returned error %q, want %q", got, want)
+	}
+	if got, want := build.GetStatus(), "errored"; got != want {
+		t.Errorf("Repositories.WaitForPagesBuild returned status %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_WaitForPagesBuild_canceled(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var requested bool
+	mux.HandleFunc("/repos/o/r/pages/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		requested = true
+		fmt.Fprint(w, `{"status":"queued"}`)
+	})
+	mux.HandleFunc("/repos/o/r/pages/builds/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if requested {
+			cancel()
+		}
+		fmt.Fprint(w, `{"url":"b1","status":"building"}`)
+	})
+
+	_, _, err := client.Repositories.WaitForPagesBuild(ctx, "o", "r", time.Millisecond)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Repositories.WaitForPagesBuild returned error %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestRepositoriesService_GetPageHealthCheck(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -14,6 +14,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -110,6 +112,120 @@ func (s *RepositoriesService) ListReleases(ctx context.Context, owner, repo stri
 	return releases, resp, nil
 }
 
+// ListLatestReleasesPerMajor returns the newest non-draft release for each
+// major version, keyed by the major version number. Release tags are parsed
+// as semantic versions with an optional "v" prefix; releases whose tags are
+// not semantic versions are skipped. A final release takes precedence over
+// pre-releases of the same version.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/releases#list-releases
+//
+//meta:operation GET /repos/{owner}/{repo}/releases
+func (s *RepositoriesService) ListLatestReleasesPerMajor(ctx context.Context, owner, repo string) (map[int]*RepositoryRelease, *Response, error) {
+	latest := make(map[int]*RepositoryRelease)
+	versions := make(map[int]*releaseVersion)
+
+	opts := &ListOptions{PerPage: 100}
+	for {
+		releases, resp, err := s.ListReleases(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, r := range releases {
+			if r.GetDraft() {
+				continue
+			}
+			v, ok := parseReleaseVersion(r.GetTagName())
+			if !ok {
+				continue
+			}
+			if cur, ok := versions[v.major]; !ok || cur.less(v) {
+				versions[v.major] = v
+				latest[v.major] = r
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return latest, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+var releaseVersionRE = regexp.MustCompile(`^[vV]?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// releaseVersion is a parsed semantic version. Build metadata is ignored.
+type releaseVersion struct {
+	major, minor, patch int
+	prerelease          []string
+}
+
+// parseReleaseVersion parses tag as a semantic version, such as "v1.2.3" or
+// "1.2.3-rc.1". It reports false if tag is not a semantic version.
+func parseReleaseVersion(tag string) (*releaseVersion, bool) {
+	m := releaseVersionRE.FindStringSubmatch(tag)
+	if m == nil {
+		return nil, false
+	}
+
+	v := new(releaseVersion)
+	for i, p := range []*int{&v.major, &v.minor, &v.patch} {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return nil, false
+		}
+		*p = n
+	}
+	if m[4] != "" {
+		v.prerelease = strings.Split(m[4], ".")
+	}
+	return v, true
+}
+
+// less reports whether v has lower precedence than w, following the
+// semantic versioning precedence rules.
+func (v *releaseVersion) less(w *releaseVersion) bool {
+	if v.major != w.major {
+		return v.major < w.major
+	}
+	if v.minor != w.minor {
+		return v.minor < w.minor
+	}
+	if v.patch != w.patch {
+		return v.patch < w.patch
+	}
+
+	// A pre-release has lower precedence than the associated normal version.
+	switch {
+	case len(v.prerelease) == 0:
+		return false
+	case len(w.prerelease) == 0:
+		return true
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(w.prerelease); i++ {
+		a, b := v.prerelease[i], w.prerelease[i]
+		if a == b {
+			continue
+		}
+		an, aErr := strconv.Atoi(a)
+		bn, bErr := strconv.Atoi(b)
+		switch {
+		case aErr == nil && bErr == nil:
+			return an < bn
+		case aErr == nil:
+			// Numeric identifiers have lower precedence than alphanumeric ones.
+			return true
+		case bErr == nil:
+			return false
+		default:
+			return a < b
+		}
+	}
+	return len(v.prerelease) < len(w.prerelease)
+}
+
 // GetRelease fetches a single release.
 //
 // GitHub API docs: https://docs.github.com/rest/releases/releases#get-a-release
@@ -279,6 +395,74 @@ func (s *RepositoriesService) DeleteRelease(ctx context.Context, owner, repo str
 	return s.client.Do(ctx, req, nil)
 }
 
+// DeleteReleaseAndTagResult reports which operations were performed by
+// RepositoriesService.DeleteReleaseAndTag.
+type DeleteReleaseAndTagResult struct {
+	// ReleaseDeleted reports whether the release was deleted.
+	ReleaseDeleted bool
+	// TagDeleted reports whether the release's tag was deleted. It is false
+	// if the tag no longer existed.
+	TagDeleted bool
+}
+
+// DeleteReleaseAndTag deletes a release and then the git tag it points to.
+// Deleting a release alone leaves its tag in place. A tag that no longer
+// exists is not treated as an error.
+//
+// GitHub API docs: https://docs.github.com/rest/git/refs#delete-a-reference
+// GitHub API docs: https://docs.github.com/rest/releases/releases#delete-a-release
+// GitHub API docs: https://docs.github.com/rest/releases/releases#get-a-release
+//
+//meta:operation DELETE /repos/{owner}/{repo}/git/refs/{ref}
+//meta:operation DELETE /repos/{owner}/{repo}/releases/{release_id}
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}
+func (s *RepositoriesService) DeleteReleaseAndTag(ctx context.Context, owner, repo string, releaseID int64) (*DeleteReleaseAndTagResult, *Response, error) {
+	release, resp, err := s.GetRelease(ctx, owner, repo, releaseID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := new(DeleteReleaseAndTagResult)
+	resp, err = s.DeleteRelease(ctx, owner, repo, releaseID)
+	if err != nil {
+		return result, resp, err
+	}
+	result.ReleaseDeleted = true
+
+	tag := release.GetTagName()
+	if tag == "" {
+		return result, resp, nil
+	}
+
+	resp, err = s.client.Git.DeleteRef(ctx, owner, repo, "tags/"+tag)
+	if err != nil {
+		if isMissingRef(err) {
+			return result, resp, nil
+		}
+		return result, resp, err
+	}
+	result.TagDeleted = true
+
+	return result, resp, nil
+}
+
+// isMissingRef reports whether err is GitHub's answer to deleting a ref
+// that does not exist. GitHub answers 422 "Reference does not exist" for
+// such refs, but also 422 for other failures such as rule violations.
+func isMissingRef(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	switch errResp.Response.StatusCode {
+	case http.StatusNotFound:
+		return true
+	case http.StatusUnprocessableEntity:
+		return errResp.Message == "Reference does not exist"
+	}
+	return false
+}
+
 // ListReleaseAssets lists the release's assets.
 //
 // GitHub API docs: https://docs.github.com/rest/releases/assets#list-release-assets
@@ -474,3 +658,75 @@ func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, rep
 	}
 	return asset, resp, nil
 }
+
+// ReplaceReleaseAsset uploads an asset named name to a release, deleting any
+// existing asset with the same name first. It reads size bytes from r. If
+// contentType is empty, "application/octet-stream" is used.
+//
+// If GitHub stores the upload under a different name, for example because
+// the deleted asset's name has not been released yet, the new asset is
+// renamed to name.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/assets#delete-a-release-asset
+// GitHub API docs: https://docs.github.com/rest/releases/assets#list-release-assets
+// GitHub API docs: https://docs.github.com/rest/releases/assets#update-a-release-asset
+// GitHub API docs: https://docs.github.com/rest/releases/assets#upload-a-release-asset
+//
+//meta:operation DELETE /repos/{owner}/{repo}/releases/assets/{asset_id}
+//meta:operation PATCH /repos/{owner}/{repo}/releases/assets/{asset_id}
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/assets
+//meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/assets
+func (s *RepositoriesService) ReplaceReleaseAsset(ctx context.Context, owner, repo string, releaseID int64, name string, r io.Reader, size int64, contentType string) (*ReleaseAsset, *Response, error) {
+	if name == "" {
+		return nil, nil, errors.New("asset name must be provided")
+	}
+
+	// Asset names are unique within a release, so listing stops at the
+	// match: deleting it shifts the assets of the following pages.
+	opts := &ListOptions{PerPage: 100}
+list:
+	for {
+		assets, resp, err := s.ListReleaseAssets(ctx, owner, repo, releaseID, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, asset := range assets {
+			if asset.GetName() != name {
+				continue
+			}
+			if resp, err := s.DeleteReleaseAsset(ctx, owner, repo, asset.GetID()); err != nil {
+				return nil, resp, err
+			}
+			break list
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, releaseID)
+	u, err := addOptions(u, &UploadOptions{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewUploadRequest(u, r, size, contentType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asset := new(ReleaseAsset)
+	resp, err := s.client.Do(ctx, req, asset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if asset.GetName() != name {
+		return s.EditReleaseAsset(ctx, owner, repo, asset.GetID(), &ReleaseAsset{Name: Ptr(name)})
+	}
+
+	return asset, resp, nil
+}
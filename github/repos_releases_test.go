@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -54,6 +55,97 @@ func TestRepositoriesService_ListReleases(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListLatestReleasesPerMajor(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/releases?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[
+				{"id":1,"tag_name":"v2.1.0-rc.1"},
+				{"id":2,"tag_name":"v2.0.0"},
+				{"id":3,"tag_name":"v3.0.0","draft":true},
+				{"id":4,"tag_name":"1.10.0"},
+				{"id":5,"tag_name":"nightly"}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"id":6,"tag_name":"v1.9.3"},
+				{"id":7,"tag_name":"v2.0.1"},
+				{"id":8,"tag_name":"v1.2"}
+			]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.ListLatestReleasesPerMajor(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ListLatestReleasesPerMajor returned error: %v", err)
+	}
+
+	want := map[int]*RepositoryRelease{
+		1: {ID: Ptr(int64(4)), TagName: Ptr("1.10.0")},
+		2: {ID: Ptr(int64(1)), TagName: Ptr("v2.1.0-rc.1")},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListLatestReleasesPerMajor returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListLatestReleasesPerMajor"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListLatestReleasesPerMajor(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestReleaseVersion_less(t *testing.T) {
+	t.Parallel()
+	// Tags in increasing order of precedence.
+	tags := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"v1.0.0+build.5",
+		"1.0.1",
+		"v1.2.0",
+		"1.10.0",
+		"2.0.0",
+	}
+
+	for i := 0; i+1 < len(tags); i++ {
+		a, ok := parseReleaseVersion(tags[i])
+		if !ok {
+			t.Fatalf("parseReleaseVersion(%q) failed", tags[i])
+		}
+		b, ok := parseReleaseVersion(tags[i+1])
+		if !ok {
+			t.Fatalf("parseReleaseVersion(%q) failed", tags[i+1])
+		}
+		if !a.less(b) {
+			t.Errorf("%q.less(%q) = false, want true", tags[i], tags[i+1])
+		}
+		if b.less(a) {
+			t.Errorf("%q.less(%q) = true, want false", tags[i+1], tags[i])
+		}
+	}
+
+	for _, tag := range []string{"", "v1", "1.2", "release-1.0.0", "01.0.0", "1.0.0-"} {
+		if _, ok := parseReleaseVersion(tag); ok {
+			t.Errorf("parseReleaseVersion(%q) = true, want false", tag)
+		}
+	}
+}
+
 func TestRepositoriesService_GenerateReleaseNotes(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -353,6 +445,127 @@ func TestRepositoriesService_DeleteRelease(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_DeleteReleaseAndTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		tagStatus int
+		want      *DeleteReleaseAndTagResult
+	}{
+		{
+			name:      "tag deleted",
+			tagStatus: http.StatusNoContent,
+			want:      &DeleteReleaseAndTagResult{ReleaseDeleted: true, TagDeleted: true},
+		},
+		{
+			name:      "tag already gone",
+			tagStatus: http.StatusUnprocessableEntity,
+			want:      &DeleteReleaseAndTagResult{ReleaseDeleted: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			var releaseDeleted bool
+			mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0"}`)
+				case "DELETE":
+					releaseDeleted = true
+				default:
+					t.Errorf("unexpected method %v", r.Method)
+				}
+			})
+			mux.HandleFunc("/repos/o/r/git/refs/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				if !releaseDeleted {
+					t.Error("tag deleted before the release")
+				}
+				w.WriteHeader(tt.tagStatus)
+				if tt.tagStatus == http.StatusUnprocessableEntity {
+					fmt.Fprint(w, `{"message":"Reference does not exist"}`)
+				}
+			})
+
+			ctx := context.Background()
+			got, _, err := client.Repositories.DeleteReleaseAndTag(ctx, "o", "r", 1)
+			if err != nil {
+				t.Fatalf("Repositories.DeleteReleaseAndTag returned error: %v", err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("Repositories.DeleteReleaseAndTag returned %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_DeleteReleaseAndTag_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.DeleteReleaseAndTag(ctx, "o", "r", 1)
+	if err == nil {
+		t.Fatal("Repositories.DeleteReleaseAndTag returned nil error, want error")
+	}
+	want := &DeleteReleaseAndTagResult{ReleaseDeleted: true}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.DeleteReleaseAndTag returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "DeleteReleaseAndTag"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.DeleteReleaseAndTag(ctx, "\n", "\n", 1)
+		return err
+	})
+}
+
+func TestRepositoriesService_DeleteReleaseAndTag_ruleViolation(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Repository rule violations found"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.DeleteReleaseAndTag(ctx, "o", "r", 1)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Repositories.DeleteReleaseAndTag returned error %v, want %v", err, ErrValidation)
+	}
+	want := &DeleteReleaseAndTagResult{ReleaseDeleted: true}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.DeleteReleaseAndTag returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_ListReleaseAssets(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -768,6 +981,156 @@ func TestRepositoriesService_UploadReleaseAsset(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ReplaceReleaseAsset(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		assets        string
+		uploaded      string
+		wantDeleted   bool
+		wantRenamed   bool
+		wantAssetID   int64
+		wantAssetName string
+	}{
+		{
+			name:          "first upload",
+			assets:        `[{"id":2,"name":"other.zip"}]`,
+			uploaded:      `{"id":10,"name":"a.zip"}`,
+			wantAssetID:   10,
+			wantAssetName: "a.zip",
+		},
+		{
+			name:          "replace",
+			assets:        `[{"id":1,"name":"a.zip"},{"id":2,"name":"other.zip"}]`,
+			uploaded:      `{"id":10,"name":"a.zip"}`,
+			wantDeleted:   true,
+			wantAssetID:   10,
+			wantAssetName: "a.zip",
+		},
+		{
+			name:          "renamed by GitHub",
+			assets:        `[{"id":1,"name":"a.zip"}]`,
+			uploaded:      `{"id":10,"name":"default.a.zip"}`,
+			wantDeleted:   true,
+			wantRenamed:   true,
+			wantAssetID:   10,
+			wantAssetName: "a.zip",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			var deleted, renamed bool
+			mux.HandleFunc("/repos/o/r/releases/5/assets", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					testFormValues(t, r, values{"per_page": "100"})
+					fmt.Fprint(w, tt.assets)
+				case "POST":
+					if tt.wantDeleted && !deleted {
+						t.Error("asset uploaded before the existing one was deleted")
+					}
+					testFormValues(t, r, values{"name": "a.zip"})
+					testHeader(t, r, "Content-Type", "application/zip")
+					testBody(t, r, "data")
+					fmt.Fprint(w, tt.uploaded)
+				default:
+					t.Errorf("unexpected method %v", r.Method)
+				}
+			})
+			mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				deleted = true
+			})
+			mux.HandleFunc("/repos/o/r/releases/assets/10", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				testBody(t, r, `{"name":"a.zip"}`+"\n")
+				renamed = true
+				fmt.Fprint(w, `{"id":10,"name":"a.zip"}`)
+			})
+
+			ctx := context.Background()
+			asset, _, err := client.Repositories.ReplaceReleaseAsset(ctx, "o", "r", 5, "a.zip", strings.NewReader("data"), 4, "application/zip")
+			if err != nil {
+				t.Fatalf("Repositories.ReplaceReleaseAsset returned error: %v", err)
+			}
+
+			want := &ReleaseAsset{ID: Ptr(tt.wantAssetID), Name: Ptr(tt.wantAssetName)}
+			if !cmp.Equal(asset, want) {
+				t.Errorf("Repositories.ReplaceReleaseAsset returned %+v, want %+v", asset, want)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("existing asset deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if renamed != tt.wantRenamed {
+				t.Errorf("new asset renamed = %v, want %v", renamed, tt.wantRenamed)
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_ReplaceReleaseAsset_secondPage(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	var deleted bool
+	mux.HandleFunc("/repos/o/r/releases/5/assets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			switch r.FormValue("page") {
+			case "":
+				w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/releases/5/assets?per_page=100&page=2>; rel="next"`, serverURL))
+				fmt.Fprint(w, `[{"id":2,"name":"other.zip"}]`)
+			case "2":
+				w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/releases/5/assets?per_page=100&page=3>; rel="next"`, serverURL))
+				fmt.Fprint(w, `[{"id":1,"name":"a.zip"}]`)
+			default:
+				t.Errorf("page %v listed after the existing asset was deleted", r.FormValue("page"))
+				fmt.Fprint(w, `[]`)
+			}
+		case "POST":
+			if !deleted {
+				t.Error("asset uploaded before the existing one was deleted")
+			}
+			fmt.Fprint(w, `{"id":10,"name":"a.zip"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+	})
+
+	ctx := context.Background()
+	asset, _, err := client.Repositories.ReplaceReleaseAsset(ctx, "o", "r", 5, "a.zip", strings.NewReader("data"), 4, "application/zip")
+	if err != nil {
+		t.Fatalf("Repositories.ReplaceReleaseAsset returned error: %v", err)
+	}
+
+	want := &ReleaseAsset{ID: Ptr(int64(10)), Name: Ptr("a.zip")}
+	if !cmp.Equal(asset, want) {
+		t.Errorf("Repositories.ReplaceReleaseAsset returned %+v, want %+v", asset, want)
+	}
+	if !deleted {
+		t.Error("existing asset on the second page was not deleted")
+	}
+}
+
+func TestRepositoriesService_ReplaceReleaseAsset_invalidName(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	_, _, err := client.Repositories.ReplaceReleaseAsset(ctx, "o", "r", 5, "", strings.NewReader("data"), 4, "")
+	if err == nil {
+		t.Error("Repositories.ReplaceReleaseAsset returned nil error, want error")
+	}
+}
+
 func TestRepositoryReleaseRequest_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &repositoryReleaseRequest{}, "{}")
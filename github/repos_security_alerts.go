@@ -0,0 +1,234 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// SecurityAlertKind identifies the service a SecurityAlert comes from.
+type SecurityAlertKind string
+
+// This is the set of kinds of security alerts returned by
+// RepositoriesService.ListSecurityAlerts.
+const (
+	SecurityAlertKindDependabot     SecurityAlertKind = "dependabot"
+	SecurityAlertKindCodeScanning   SecurityAlertKind = "code_scanning"
+	SecurityAlertKindSecretScanning SecurityAlertKind = "secret_scanning"
+)
+
+// SecurityAlert is a Dependabot, code scanning or secret scanning alert
+// normalized by RepositoriesService.ListSecurityAlerts.
+type SecurityAlert struct {
+	Kind   SecurityAlertKind
+	Number int
+	// Severity is the advisory severity of Dependabot alerts and the
+	// security severity level, or else the rule severity, of code scanning
+	// alerts. Secret scanning alerts have no severity.
+	Severity  string
+	State     string
+	CreatedAt Timestamp
+	HTMLURL   string
+	// Raw is the original alert: a *DependabotAlert, *Alert or
+	// *SecretScanningAlert depending on Kind.
+	Raw interface{}
+}
+
+// SecurityAlertList is the result of RepositoriesService.ListSecurityAlerts.
+type SecurityAlertList struct {
+	Alerts []*SecurityAlert
+	// Unavailable lists the services that answered 403 Forbidden or 404 Not
+	// Found, for example because GitHub Advanced Security is not enabled on
+	// the repository. Alerts contains no alerts of these kinds.
+	Unavailable []SecurityAlertKind
+}
+
+// SecurityAlertsOptions specifies the optional parameters to the
+// RepositoriesService.ListSecurityAlerts method.
+type SecurityAlertsOptions struct {
+	// State only lists alerts in this state. The three services use
+	// different states; "open" is the only one they all accept.
+	State string
+}
+
+// ListSecurityAlerts lists the Dependabot, code scanning and secret scanning
+// alerts of a repository, newest first. The three services are queried
+// concurrently and all pages are fetched. A service that answers 403
+// Forbidden or 404 Not Found is reported in Unavailable and the alerts of
+// the other services are still returned. Any other error cancels the other
+// requests and is returned along with its Response. Otherwise the returned
+// Response is the last one received from any of the services.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#list-code-scanning-alerts-for-a-repository
+// GitHub API docs: https://docs.github.com/rest/dependabot/alerts#list-dependabot-alerts-for-a-repository
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-secret-scanning-alerts-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts
+//meta:operation GET /repos/{owner}/{repo}/dependabot/alerts
+//meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts
+func (s *RepositoriesService) ListSecurityAlerts(ctx context.Context, owner, repo string, opts *SecurityAlertsOptions) (*SecurityAlertList, *Response, error) {
+	var state string
+	if opts != nil {
+		state = opts.State
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg                                sync.WaitGroup
+		mu                                sync.Mutex
+		firstErr                          error
+		errResp, lastResp                 *Response
+		unavailable                       []SecurityAlertKind
+		dependabot, codeScanning, secrets []*SecurityAlert
+	)
+	// record keeps r as the last response received.
+	record := func(r *Response) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r != nil {
+			lastResp = r
+		}
+	}
+	// fail records the error of the service of the given kind. Alerts of a
+	// service that fails are discarded by its goroutine.
+	fail := func(kind SecurityAlertKind, r *Response, err error) {
+		record(r)
+		mu.Lock()
+		defer mu.Unlock()
+		if errors.Is(err, ErrForbidden) || errors.Is(err, ErrNotFound) {
+			unavailable = append(unavailable, kind)
+			return
+		}
+		if firstErr == nil {
+			firstErr, errResp = err, r
+			cancel()
+		}
+	}
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		opts := &ListAlertsOptions{ListOptions: ListOptions{PerPage: 100}}
+		if state != "" {
+			opts.State = Ptr(state)
+		}
+		for {
+			alerts, r, err := s.client.Dependabot.ListRepoAlerts(ctx, owner, repo, opts)
+			if err != nil {
+				dependabot = nil
+				fail(SecurityAlertKindDependabot, r, err)
+				return
+			}
+			for _, a := range alerts {
+				dependabot = append(dependabot, &SecurityAlert{
+					Kind:      SecurityAlertKindDependabot,
+					Number:    a.GetNumber(),
+					Severity:  a.GetSecurityAdvisory().GetSeverity(),
+					State:     a.GetState(),
+					CreatedAt: a.GetCreatedAt(),
+					HTMLURL:   a.GetHTMLURL(),
+					Raw:       a,
+				})
+			}
+			record(r)
+			if !nextAlertsPage(r, &opts.ListOptions, &opts.ListCursorOptions) {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		opts := &AlertListOptions{State: state, ListOptions: ListOptions{PerPage: 100}}
+		for {
+			alerts, r, err := s.client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, opts)
+			if err != nil {
+				codeScanning = nil
+				fail(SecurityAlertKindCodeScanning, r, err)
+				return
+			}
+			for _, a := range alerts {
+				severity := a.GetRule().GetSecuritySeverityLevel()
+				if severity == "" {
+					severity = a.GetRule().GetSeverity()
+				}
+				codeScanning = append(codeScanning, &SecurityAlert{
+					Kind:      SecurityAlertKindCodeScanning,
+					Number:    a.GetNumber(),
+					Severity:  severity,
+					State:     a.GetState(),
+					CreatedAt: a.GetCreatedAt(),
+					HTMLURL:   a.GetHTMLURL(),
+					Raw:       a,
+				})
+			}
+			record(r)
+			if !nextAlertsPage(r, &opts.ListOptions, &opts.ListCursorOptions) {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		opts := &SecretScanningAlertListOptions{State: state, ListOptions: ListOptions{PerPage: 100}}
+		for {
+			alerts, r, err := s.client.SecretScanning.ListAlertsForRepo(ctx, owner, repo, opts)
+			if err != nil {
+				secrets = nil
+				fail(SecurityAlertKindSecretScanning, r, err)
+				return
+			}
+			for _, a := range alerts {
+				secrets = append(secrets, &SecurityAlert{
+					Kind:      SecurityAlertKindSecretScanning,
+					Number:    a.GetNumber(),
+					State:     a.GetState(),
+					CreatedAt: a.GetCreatedAt(),
+					HTMLURL:   a.GetHTMLURL(),
+					Raw:       a,
+				})
+			}
+			record(r)
+			if !nextAlertsPage(r, &opts.ListOptions, &opts.ListCursorOptions) {
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, errResp, firstErr
+	}
+
+	alerts := slices.Concat(dependabot, codeScanning, secrets)
+	slices.SortStableFunc(alerts, func(a, b *SecurityAlert) int {
+		return b.CreatedAt.Compare(a.CreatedAt.Time)
+	})
+
+	slices.Sort(unavailable)
+
+	return &SecurityAlertList{Alerts: alerts, Unavailable: unavailable}, lastResp, nil
+}
+
+// nextAlertsPage points page or cursor at the page following resp and
+// reports whether there is one. The alert endpoints paginate either with an
+// "after" cursor or with page numbers, depending on the GitHub product.
+func nextAlertsPage(resp *Response, page *ListOptions, cursor *ListCursorOptions) bool {
+	switch {
+	case resp.After != "":
+		cursor.After = resp.After
+		return true
+	case resp.NextPage != 0:
+		page.Page = resp.NextPage
+		return true
+	default:
+		return false
+	}
+}
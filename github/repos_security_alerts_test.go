@@ -0,0 +1,162 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_ListSecurityAlerts(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "":
+			testFormValues(t, r, values{"state": "open", "per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/dependabot/alerts?per_page=100&after=c1>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"number":1,"state":"open","created_at":"2024-01-01T00:00:00Z","security_advisory":{"severity":"high"}}]`)
+		case "c1":
+			fmt.Fprint(w, `[{"number":2,"state":"open","created_at":"2024-01-04T00:00:00Z","security_advisory":{"severity":"low"}}]`)
+		default:
+			t.Errorf("unexpected after %q", r.FormValue("after"))
+		}
+	})
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "per_page": "100"})
+		fmt.Fprint(w, `[
+			{"number":3,"state":"open","created_at":"2024-01-03T00:00:00Z","rule":{"severity":"error","security_severity_level":"critical"}},
+			{"number":4,"state":"open","created_at":"2024-01-02T00:00:00Z","rule":{"severity":"warning"}}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "per_page": "100"})
+		fmt.Fprint(w, `[{"number":5,"state":"open","created_at":"2024-01-05T00:00:00Z","html_url":"h5"}]`)
+	})
+
+	ctx := context.Background()
+	list, _, err := client.Repositories.ListSecurityAlerts(ctx, "o", "r", &SecurityAlertsOptions{State: "open"})
+	if err != nil {
+		t.Fatalf("Repositories.ListSecurityAlerts returned error: %v", err)
+	}
+	if len(list.Unavailable) != 0 {
+		t.Errorf("Repositories.ListSecurityAlerts returned Unavailable %v, want none", list.Unavailable)
+	}
+	alerts := list.Alerts
+
+	type alert struct {
+		Kind      SecurityAlertKind
+		Number    int
+		Severity  string
+		State     string
+		CreatedAt time.Time
+		HTMLURL   string
+	}
+	var got []alert
+	for _, a := range alerts {
+		got = append(got, alert{a.Kind, a.Number, a.Severity, a.State, a.CreatedAt.Time, a.HTMLURL})
+	}
+	day := func(d int) time.Time { return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC) }
+	want := []alert{
+		{SecurityAlertKindSecretScanning, 5, "", "open", day(5), "h5"},
+		{SecurityAlertKindDependabot, 2, "low", "open", day(4), ""},
+		{SecurityAlertKindCodeScanning, 3, "critical", "open", day(3), ""},
+		{SecurityAlertKindCodeScanning, 4, "warning", "open", day(2), ""},
+		{SecurityAlertKindDependabot, 1, "high", "open", day(1), ""},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListSecurityAlerts returned %+v, want %+v", got, want)
+	}
+
+	if raw, ok := alerts[0].Raw.(*SecretScanningAlert); !ok || raw.GetNumber() != 5 {
+		t.Errorf("Repositories.ListSecurityAlerts Raw = %#v, want *SecretScanningAlert #5", alerts[0].Raw)
+	}
+	if raw, ok := alerts[1].Raw.(*DependabotAlert); !ok || raw.GetNumber() != 2 {
+		t.Errorf("Repositories.ListSecurityAlerts Raw = %#v, want *DependabotAlert #2", alerts[1].Raw)
+	}
+	if raw, ok := alerts[2].Raw.(*Alert); !ok || raw.GetNumber() != 3 {
+		t.Errorf("Repositories.ListSecurityAlerts Raw = %#v, want *Alert #3", alerts[2].Raw)
+	}
+}
+
+func TestRepositoriesService_ListSecurityAlerts_unavailable(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"number":1,"state":"open","created_at":"2024-01-01T00:00:00Z"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Advanced Security must be enabled"}`)
+	})
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Secret scanning is disabled on this repository."}`)
+	})
+
+	ctx := context.Background()
+	list, resp, err := client.Repositories.ListSecurityAlerts(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Repositories.ListSecurityAlerts returned error: %v", err)
+	}
+
+	if got, want := len(list.Alerts), 1; got != want {
+		t.Fatalf("Repositories.ListSecurityAlerts returned %v alerts, want %v", got, want)
+	}
+	if got, want := list.Alerts[0].Kind, SecurityAlertKindDependabot; got != want {
+		t.Errorf("Repositories.ListSecurityAlerts returned alert of kind %v, want %v", got, want)
+	}
+	wantUnavailable := []SecurityAlertKind{SecurityAlertKindCodeScanning, SecurityAlertKindSecretScanning}
+	if !cmp.Equal(list.Unavailable, wantUnavailable) {
+		t.Errorf("Repositories.ListSecurityAlerts returned Unavailable %v, want %v", list.Unavailable, wantUnavailable)
+	}
+	if resp == nil {
+		t.Error("Repositories.ListSecurityAlerts returned nil Response")
+	}
+}
+
+func TestRepositoriesService_ListSecurityAlerts_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/repos/o/r/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"message":"Server Error"}`)
+	})
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	list, resp, err := client.Repositories.ListSecurityAlerts(ctx, "o", "r", nil)
+	if err == nil {
+		t.Fatal("Repositories.ListSecurityAlerts returned nil error, want error")
+	}
+	if list != nil {
+		t.Errorf("Repositories.ListSecurityAlerts returned %+v, want nil", list)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Repositories.ListSecurityAlerts returned error %v, want a 500 *ErrorResponse", err)
+	}
+	if got, want := resp.StatusCode, http.StatusInternalServerError; got != want {
+		t.Errorf("Repositories.ListSecurityAlerts returned status %v, want %v", got, want)
+	}
+}
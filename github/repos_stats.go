@@ -7,10 +7,34 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 )
 
+// ErrStatsComputing is returned by the statistics Wait methods when GitHub
+// is still computing the requested statistics after the last attempt or when
+// the context is done.
+var ErrStatsComputing = errors.New("github: repository statistics are still being computed")
+
+// waitForStats calls list until it stops returning an *AcceptedError,
+// backing off between attempts.
+func waitForStats[T any](ctx context.Context, c *Client, list func() (T, *Response, error)) (T, *Response, error) {
+	var zero T
+	for attempt := 0; ; attempt++ {
+		v, resp, err := list()
+		if !errors.As(err, new(*AcceptedError)) {
+			return v, resp, err
+		}
+		if attempt == maxPollAttempts-1 {
+			return zero, resp, ErrStatsComputing
+		}
+		if err := c.sleepBeforePoll(ctx, attempt); err != nil {
+			return zero, resp, fmt.Errorf("%w: %w", ErrStatsComputing, err)
+		}
+	}
+}
+
 // ContributorStats represents a contributor to a repository and their
 // weekly contributions to a given repo.
 type ContributorStats struct {
@@ -64,6 +88,21 @@ func (s *RepositoriesService) ListContributorsStats(ctx context.Context, owner,
 	return contributorStats, resp, nil
 }
 
+// ListContributorsStatsWait is like ListContributorsStats, but when GitHub
+// responds with 202 Accepted while it computes the statistics, it retries with
+// an increasing delay until the statistics are available. If they are still
+// not available after several attempts or ctx is done first, it returns an
+// error wrapping ErrStatsComputing.
+//
+// GitHub API docs: https://docs.github.com/rest/metrics/statistics#get-all-contributor-commit-activity
+//
+//meta:operation GET /repos/{owner}/{repo}/stats/contributors
+func (s *RepositoriesService) ListContributorsStatsWait(ctx context.Context, owner, repo string) ([]*ContributorStats, *Response, error) {
+	return waitForStats(ctx, s.client, func() ([]*ContributorStats, *Response, error) {
+		return s.ListContributorsStats(ctx, owner, repo)
+	})
+}
+
 // WeeklyCommitActivity represents the weekly commit activity for a repository.
 // The days array is a group of commits per day, starting on Sunday.
 type WeeklyCommitActivity struct {
@@ -105,6 +144,18 @@ func (s *RepositoriesService) ListCommitActivity(ctx context.Context, owner, rep
 	return weeklyCommitActivity, resp, nil
 }
 
+// ListCommitActivityWait is like ListCommitActivity, but retries while GitHub
+// is computing the statistics. See ListContributorsStatsWait for details.
+//
+// GitHub API docs: https://docs.github.com/rest/metrics/statistics#get-the-last-year-of-commit-activity
+//
+//meta:operation GET /repos/{owner}/{repo}/stats/commit_activity
+func (s *RepositoriesService) ListCommitActivityWait(ctx context.Context, owner, repo string) ([]*WeeklyCommitActivity, *Response, error) {
+	return waitForStats(ctx, s.client, func() ([]*WeeklyCommitActivity, *Response, error) {
+		return s.ListCommitActivity(ctx, owner, repo)
+	})
+}
+
 // ListCodeFrequency returns a weekly aggregate of the number of additions and
 // deletions pushed to a repository. Returned WeeklyStats will contain
 // additions and deletions, but not total commits.
@@ -148,6 +199,18 @@ func (s *RepositoriesService) ListCodeFrequency(ctx context.Context, owner, repo
 	return stats, resp, nil
 }
 
+// ListCodeFrequencyWait is like ListCodeFrequency, but retries while GitHub
+// is computing the statistics. See ListContributorsStatsWait for details.
+//
+// GitHub API docs: https://docs.github.com/rest/metrics/statistics#get-the-weekly-commit-activity
+//
+//meta:operation GET /repos/{owner}/{repo}/stats/code_frequency
+func (s *RepositoriesService) ListCodeFrequencyWait(ctx context.Context, owner, repo string) ([]*WeeklyStats, *Response, error) {
+	return waitForStats(ctx, s.client, func() ([]*WeeklyStats, *Response, error) {
+		return s.ListCodeFrequency(ctx, owner, repo)
+	})
+}
+
 // RepositoryParticipation is the number of commits by everyone
 // who has contributed to the repository (including the owner)
 // as well as the number of commits by the owner themself.
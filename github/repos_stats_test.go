@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -328,6 +329,166 @@ func TestRepositoriesService_AcceptedError(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListContributorsStatsWait(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Millisecond
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/stats/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `[{"total": 135}]`)
+	})
+
+	ctx := context.Background()
+	stats, _, err := client.Repositories.ListContributorsStatsWait(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait returned error: %v", err)
+	}
+
+	want := []*ContributorStats{{Total: Ptr(135)}}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait returned %+v, want %+v", stats, want)
+	}
+	if calls != 2 {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait made %v requests, want 2", calls)
+	}
+
+	const methodName = "ListContributorsStatsWait"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListContributorsStatsWait(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_ListContributorsStatsWait_stillComputing(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Millisecond
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/stats/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	stats, _, err := client.Repositories.ListContributorsStatsWait(ctx, "o", "r")
+	if !errors.Is(err, ErrStatsComputing) {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait returned error %v, want ErrStatsComputing", err)
+	}
+	if stats != nil {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait returned %+v, want nil", stats)
+	}
+	if calls != maxPollAttempts {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait made %v requests, want %v", calls, maxPollAttempts)
+	}
+}
+
+func TestRepositoriesService_ListContributorsStatsWait_contextDone(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Hour
+
+	mux.HandleFunc("/repos/o/r/stats/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Repositories.ListContributorsStatsWait(ctx, "o", "r")
+	if !errors.Is(err, ErrStatsComputing) {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait returned error %v, want ErrStatsComputing", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RepositoriesService.ListContributorsStatsWait returned error %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRepositoriesService_ListCommitActivityWait(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Millisecond
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/stats/commit_activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `[{"total": 89}]`)
+	})
+
+	ctx := context.Background()
+	activity, _, err := client.Repositories.ListCommitActivityWait(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("RepositoriesService.ListCommitActivityWait returned error: %v", err)
+	}
+
+	want := []*WeeklyCommitActivity{{Total: Ptr(89)}}
+	if !cmp.Equal(activity, want) {
+		t.Errorf("RepositoriesService.ListCommitActivityWait returned %+v, want %+v", activity, want)
+	}
+
+	const methodName = "ListCommitActivityWait"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListCommitActivityWait(ctx, "\n", "\n")
+		return err
+	})
+}
+
+func TestRepositoriesService_ListCodeFrequencyWait(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Millisecond
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/stats/code_frequency", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `[[1302998400, 1124, -435]]`)
+	})
+
+	ctx := context.Background()
+	code, _, err := client.Repositories.ListCodeFrequencyWait(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("RepositoriesService.ListCodeFrequencyWait returned error: %v", err)
+	}
+
+	want := []*WeeklyStats{{
+		Week:      &Timestamp{time.Date(2011, time.April, 17, 00, 00, 00, 0, time.UTC).Local()},
+		Additions: Ptr(1124),
+		Deletions: Ptr(-435),
+	}}
+	if !cmp.Equal(code, want) {
+		t.Errorf("RepositoriesService.ListCodeFrequencyWait returned %+v, want %+v", code, want)
+	}
+
+	const methodName = "ListCodeFrequencyWait"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListCodeFrequencyWait(ctx, "\n", "\n")
+		return err
+	})
+}
+
 func TestRepositoryParticipation_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &RepositoryParticipation{}, "{}")
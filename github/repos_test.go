@@ -353,6 +353,100 @@ func TestRepositoriesService_CreateFromTemplate(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateFromTemplate_includeAllBranches(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/to/tr/generate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"n","owner":"o","include_all_branches":true,"private":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"name":"n"}`)
+	})
+
+	templateRepoReq := &TemplateRepoRequest{
+		Name:               Ptr("n"),
+		Owner:              Ptr("o"),
+		IncludeAllBranches: Ptr(true),
+		Private:            Ptr(false),
+	}
+	ctx := context.Background()
+	if _, _, err := client.Repositories.CreateFromTemplate(ctx, "to", "tr", templateRepoReq); err != nil {
+		t.Errorf("Repositories.CreateFromTemplate returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_WaitForTemplateGeneration(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Millisecond
+
+	var calls int
+	mux.HandleFunc("/repos/o/n", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"name":"n"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.WaitForTemplateGeneration(ctx, "o", "n")
+	if err != nil {
+		t.Errorf("Repositories.WaitForTemplateGeneration returned error: %v", err)
+	}
+
+	want := &Repository{ID: Ptr(int64(1)), Name: Ptr("n")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.WaitForTemplateGeneration returned %+v, want %+v", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("Repositories.WaitForTemplateGeneration made %v requests, want 2", calls)
+	}
+
+	const methodName = "WaitForTemplateGeneration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.WaitForTemplateGeneration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.WaitForTemplateGeneration(ctx, "o", "n")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_WaitForTemplateGeneration_notFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.pollDelay = time.Millisecond
+
+	var calls int
+	mux.HandleFunc("/repos/o/n", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.WaitForTemplateGeneration(ctx, "o", "n")
+	if err == nil {
+		t.Error("Repositories.WaitForTemplateGeneration returned nil error, want 404")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.WaitForTemplateGeneration returned response %+v, want 404", resp)
+	}
+	if calls != maxPollAttempts {
+		t.Errorf("Repositories.WaitForTemplateGeneration made %v requests, want %v", calls, maxPollAttempts)
+	}
+}
+
 func TestRepositoriesService_Get(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -515,6 +609,70 @@ func TestRepositoriesService_Edit(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Patch(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := map[string]interface{}{"has_issues": false}
+
+	wantAcceptHeaders := []string{mediaTypeRepositoryTemplatePreview, mediaTypeRepositoryVisibilityPreview}
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", strings.Join(wantAcceptHeaders, ", "))
+		testBody(t, r, `{"has_issues":false}`+"\n")
+		fmt.Fprint(w, `{"id":1,"has_issues":false}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.Patch(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.Patch returned error: %v", err)
+	}
+
+	want := &Repository{ID: Ptr(int64(1)), HasIssues: Ptr(false)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.Patch returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "Patch"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.Patch(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.Patch(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_Patch_invalidFields(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, fields := range []map[string]interface{}{
+		nil,
+		{"has_issues": true, "stargazers_count": 1, "owner": "o2"},
+	} {
+		_, resp, err := client.Repositories.Patch(ctx, "o", "r", fields)
+		if err == nil {
+			t.Errorf("Repositories.Patch(%v) returned nil error, want error", fields)
+		}
+		if resp != nil {
+			t.Errorf("Repositories.Patch(%v) returned response %+v, want nil", fields, resp)
+		}
+	}
+
+	_, _, err := client.Repositories.Patch(ctx, "o", "r", map[string]interface{}{"stargazers_count": 1, "owner": "o2"})
+	if want := "unknown repository fields: owner, stargazers_count"; err == nil || err.Error() != want {
+		t.Errorf("Repositories.Patch returned error %v, want %q", err, want)
+	}
+}
+
 func TestRepositoriesService_Delete(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -594,6 +752,28 @@ func TestRepositoriesService_GetVulnerabilityAlerts(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetVulnerabilityAlerts_disabled(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/vulnerability-alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	vulnerabilityAlertsEnabled, resp, err := client.Repositories.GetVulnerabilityAlerts(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetVulnerabilityAlerts returned error: %v", err)
+	}
+	if vulnerabilityAlertsEnabled {
+		t.Error("Repositories.GetVulnerabilityAlerts returned true, want false")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.GetVulnerabilityAlerts returned response %+v, want status %v", resp, http.StatusNotFound)
+	}
+}
+
 func TestRepositoriesService_EnableVulnerabilityAlerts(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -757,6 +937,68 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListContributors_includeAnonymous(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"anon": "true"})
+		fmt.Fprint(w, `[]`)
+	})
+
+	opts := &ListContributorsOptions{IncludeAnonymous: true}
+	ctx := context.Background()
+	if _, _, err := client.Repositories.ListContributors(ctx, "o", "r", opts); err != nil {
+		t.Errorf("Repositories.ListContributors returned error: %v", err)
+	}
+	if opts.Anon != "" {
+		t.Errorf("Repositories.ListContributors modified opts.Anon to %q", opts.Anon)
+	}
+}
+
+func TestRepositoriesService_ListAllContributors(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"anon": "true", "per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/contributors?anon=true&per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{"login":"octocat","type":"User","contributions":10}]`)
+		case "2":
+			fmt.Fprint(w, `[{"name":"Jane","email":"jane@example.com","type":"Anonymous","contributions":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	contributors, _, err := client.Repositories.ListAllContributors(ctx, "o", "r", true)
+	if err != nil {
+		t.Fatalf("Repositories.ListAllContributors returned error: %v", err)
+	}
+
+	want := []*Contributor{
+		{Login: Ptr("octocat"), Type: Ptr("User"), Contributions: Ptr(10)},
+		{Name: Ptr("Jane"), Email: Ptr("jane@example.com"), Type: Ptr("Anonymous"), Contributions: Ptr(3)},
+	}
+	if !cmp.Equal(contributors, want) {
+		t.Errorf("Repositories.ListAllContributors returned %+v, want %+v", contributors, want)
+	}
+	if contributors[0].IsAnonymous() || !contributors[1].IsAnonymous() {
+		t.Errorf("IsAnonymous = %v, %v, want false, true", contributors[0].IsAnonymous(), contributors[1].IsAnonymous())
+	}
+
+	const methodName = "ListAllContributors"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListAllContributors(ctx, "\n", "\n", true)
+		return err
+	})
+}
+
 func TestRepositoriesService_ListLanguages(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -792,6 +1034,64 @@ func TestRepositoriesService_ListLanguages(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetLanguagePercentages(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Shell":100,"Go":700,"Python":200}`)
+	})
+
+	ctx := context.Background()
+	percentages, _, err := client.Repositories.GetLanguagePercentages(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetLanguagePercentages returned error: %v", err)
+	}
+
+	want := []*LanguagePercentage{
+		{Language: "Go", Bytes: 700, Percent: 70},
+		{Language: "Python", Bytes: 200, Percent: 20},
+		{Language: "Shell", Bytes: 100, Percent: 10},
+	}
+	if !cmp.Equal(percentages, want) {
+		t.Errorf("Repositories.GetLanguagePercentages returned %+v, want %+v", percentages, want)
+	}
+
+	const methodName = "GetLanguagePercentages"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetLanguagePercentages(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetLanguagePercentages(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetLanguagePercentages_empty(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	percentages, _, err := client.Repositories.GetLanguagePercentages(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetLanguagePercentages returned error: %v", err)
+	}
+	if percentages == nil || len(percentages) != 0 {
+		t.Errorf("Repositories.GetLanguagePercentages returned %#v, want empty slice", percentages)
+	}
+}
+
 func TestRepositoriesService_ListTeams(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -876,6 +1176,78 @@ func TestRepositoriesService_ListTags(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_ListTagsWithDates(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"v1","commit":{"sha":"c1"}},{"name":"v2","commit":{"sha":"t2"}}]`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/c1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"c1","committer":{"date":"2024-01-02T03:04:05Z"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/t2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/git/tags/t2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":"v2","sha":"t2","object":{"type":"commit","sha":"c2"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/c2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"c2","committer":{"date":"2024-02-03T04:05:06Z"}}`)
+	})
+
+	ctx := context.Background()
+	tags, _, err := client.Repositories.ListTagsWithDates(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Repositories.ListTagsWithDates returned error: %v", err)
+	}
+
+	want := []*RepositoryTagWithDate{
+		{
+			Tag:         &RepositoryTag{Name: Ptr("v1"), Commit: &Commit{SHA: Ptr("c1")}},
+			CommittedAt: time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			Tag:         &RepositoryTag{Name: Ptr("v2"), Commit: &Commit{SHA: Ptr("t2")}},
+			CommittedAt: time.Date(2024, time.February, 3, 4, 5, 6, 0, time.UTC),
+		},
+	}
+	if !cmp.Equal(tags, want) {
+		t.Errorf("Repositories.ListTagsWithDates returned %+v, want %+v", tags, want)
+	}
+}
+
+func TestRepositoriesService_ListTagsWithDates_commitError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"v1","commit":{"sha":"c1"}}]`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/c1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	tags, resp, err := client.Repositories.ListTagsWithDates(ctx, "o", "r", nil)
+	if err == nil {
+		t.Fatal("Repositories.ListTagsWithDates returned nil error, want error")
+	}
+	if tags != nil {
+		t.Errorf("Repositories.ListTagsWithDates returned %+v, want nil", tags)
+	}
+	if got, want := resp.StatusCode, http.StatusInternalServerError; got != want {
+		t.Errorf("Repositories.ListTagsWithDates returned status %v, want %v", got, want)
+	}
+}
+
 func TestRepositoriesService_ListBranches(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -1138,6 +1510,42 @@ func TestRepositoriesService_RenameBranch(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_SetDefaultBranch(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"default_branch":"main"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"default_branch":"main"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.SetDefaultBranch(ctx, "o", "r", "main")
+	if err != nil {
+		t.Errorf("Repositories.SetDefaultBranch returned error: %v", err)
+	}
+
+	want := &Repository{ID: Ptr(int64(1)), DefaultBranch: Ptr("main")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.SetDefaultBranch returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "SetDefaultBranch"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.SetDefaultBranch(ctx, "\n", "\n", "main")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.SetDefaultBranch(ctx, "o", "r", "main")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestRepositoriesService_GetBranchProtection(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -2288,7 +2696,99 @@ func TestRepositoriesService_UpdateBranchProtection_RequireLastPushApproval(t *t
 	}
 }
 
-func TestRepositoriesService_RemoveBranchProtection(t *testing.T) {
+func TestProtection_ToRequest(t *testing.T) {
+	t.Parallel()
+	checks := []*RequiredStatusCheck{{Context: "ci", AppID: Ptr(int64(1))}}
+	p := &Protection{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict:      true,
+			Contexts:    &[]string{"ci"},
+			Checks:      &checks,
+			ContextsURL: Ptr("cu"),
+			URL:         Ptr("u"),
+		},
+		RequiredPullRequestReviews: &PullRequestReviewsEnforcement{
+			BypassPullRequestAllowances: &BypassPullRequestAllowances{
+				Users: []*User{{Login: Ptr("u1")}},
+				Teams: []*Team{{Slug: Ptr("t1")}},
+				Apps:  []*App{{Slug: Ptr("a1")}},
+			},
+			DismissalRestrictions: &DismissalRestrictions{
+				Users: []*User{{Login: Ptr("u2")}},
+			},
+			DismissStaleReviews:          true,
+			RequireCodeOwnerReviews:      true,
+			RequiredApprovingReviewCount: 2,
+			RequireLastPushApproval:      true,
+		},
+		EnforceAdmins: &AdminEnforcement{URL: Ptr("u"), Enabled: true},
+		Restrictions: &BranchRestrictions{
+			Users: []*User{{Login: Ptr("u3")}},
+			Teams: []*Team{{Slug: Ptr("t3")}},
+		},
+		RequireLinearHistory:           &RequireLinearHistory{Enabled: true},
+		AllowForcePushes:               &AllowForcePushes{Enabled: false},
+		AllowDeletions:                 &AllowDeletions{Enabled: true},
+		RequiredConversationResolution: &RequiredConversationResolution{Enabled: true},
+		BlockCreations:                 &BlockCreations{Enabled: Ptr(true)},
+		LockBranch:                     &LockBranch{Enabled: Ptr(false)},
+		AllowForkSyncing:               &AllowForkSyncing{Enabled: Ptr(true)},
+		RequiredSignatures:             &SignaturesProtectedBranch{Enabled: Ptr(true)},
+		URL:                            Ptr("u"),
+	}
+
+	want := &ProtectionRequest{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict: true,
+			Checks: &checks,
+		},
+		RequiredPullRequestReviews: &PullRequestReviewsEnforcementRequest{
+			BypassPullRequestAllowancesRequest: &BypassPullRequestAllowancesRequest{
+				Users: []string{"u1"},
+				Teams: []string{"t1"},
+				Apps:  []string{"a1"},
+			},
+			DismissalRestrictionsRequest: &DismissalRestrictionsRequest{
+				Users: &[]string{"u2"},
+				Teams: &[]string{},
+				Apps:  &[]string{},
+			},
+			DismissStaleReviews:          true,
+			RequireCodeOwnerReviews:      true,
+			RequiredApprovingReviewCount: 2,
+			RequireLastPushApproval:      Ptr(true),
+		},
+		EnforceAdmins: true,
+		Restrictions: &BranchRestrictionsRequest{
+			Users: []string{"u3"},
+			Teams: []string{"t3"},
+			Apps:  []string{},
+		},
+		RequireLinearHistory:           Ptr(true),
+		AllowForcePushes:               Ptr(false),
+		AllowDeletions:                 Ptr(true),
+		RequiredConversationResolution: Ptr(true),
+		BlockCreations:                 Ptr(true),
+		LockBranch:                     Ptr(false),
+		AllowForkSyncing:               Ptr(true),
+	}
+	if got := p.ToRequest(); !cmp.Equal(got, want) {
+		t.Errorf("Protection.ToRequest returned %+v, want %+v", got, want)
+	}
+
+	// Without checks, the legacy contexts are kept.
+	p = &Protection{RequiredStatusChecks: &RequiredStatusChecks{Contexts: &[]string{"ci"}}}
+	want = &ProtectionRequest{RequiredStatusChecks: &RequiredStatusChecks{Contexts: &[]string{"ci"}}}
+	if got := p.ToRequest(); !cmp.Equal(got, want) {
+		t.Errorf("Protection.ToRequest returned %+v, want %+v", got, want)
+	}
+
+	if got := (*Protection)(nil).ToRequest(); got != nil {
+		t.Errorf("Protection.ToRequest on nil returned %+v, want nil", got)
+	}
+}
+
+func TestRepositoriesService_RemoveBranchProtection(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		branch  string
@@ -2382,6 +2882,83 @@ func TestRepositoriesService_License(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetLicense(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/license", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{})
+		// "MIT License\n" encoded as base64 and wrapped like the API does.
+		fmt.Fprint(w, `{"name":"LICENSE","encoding":"base64","content":"TUlUIExp\nY2Vuc2UK\n","license":{"key":"mit"}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetLicense(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetLicense returned error: %v", err)
+	}
+
+	want := &RepositoryLicense{
+		Name:    Ptr("LICENSE"),
+		Content: Ptr("MIT License\n"),
+		License: &License{Key: Ptr("mit")},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetLicense returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetLicense"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetLicense(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetLicense(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetLicenseForRef(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/license", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "v1.0"})
+		fmt.Fprint(w, `{"name":"COPYING","encoding":"base64","content":"R1BMCg=="}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetLicenseForRef(ctx, "o", "r", "v1.0")
+	if err != nil {
+		t.Fatalf("Repositories.GetLicenseForRef returned error: %v", err)
+	}
+
+	want := &RepositoryLicense{Name: Ptr("COPYING"), Content: Ptr("GPL\n")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetLicenseForRef returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_GetLicenseForRef_badEncoding(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/license", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"LICENSE","encoding":"base64","content":"!!!"}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.GetLicenseForRef(ctx, "o", "r", ""); err == nil {
+		t.Error("Repositories.GetLicenseForRef returned nil error for invalid content, want error")
+	}
+}
+
 func TestRepositoriesService_GetRequiredStatusChecks(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -2796,6 +3373,104 @@ func TestRepositoriesService_ListRequiredStatusChecksContexts_branchNotProtected
 	}
 }
 
+func TestRepositoriesService_AddRequiredStatusChecksContexts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		branch  string
+		urlPath string
+	}{
+		{branch: "b", urlPath: "/repos/o/r/branches/b/protection/required_status_checks/contexts"},
+		{branch: "feat/branch-50%", urlPath: "/repos/o/r/branches/feat%2fbranch-50%25/protection/required_status_checks/contexts"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.branch, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			mux.HandleFunc(test.urlPath, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, `{"contexts":["y"]}`+"\n")
+				fmt.Fprint(w, `["x", "y"]`)
+			})
+
+			ctx := context.Background()
+			contexts, _, err := client.Repositories.AddRequiredStatusChecksContexts(ctx, "o", "r", test.branch, []string{"y"})
+			if err != nil {
+				t.Errorf("Repositories.AddRequiredStatusChecksContexts returned error: %v", err)
+			}
+
+			want := []string{"x", "y"}
+			if !cmp.Equal(contexts, want) {
+				t.Errorf("Repositories.AddRequiredStatusChecksContexts returned %+v, want %+v", contexts, want)
+			}
+
+			const methodName = "AddRequiredStatusChecksContexts"
+			testBadOptions(t, methodName, func() (err error) {
+				_, _, err = client.Repositories.AddRequiredStatusChecksContexts(ctx, "\n", "\n", "\n", []string{"y"})
+				return err
+			})
+
+			testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+				got, resp, err := client.Repositories.AddRequiredStatusChecksContexts(ctx, "o", "r", test.branch, []string{"y"})
+				if got != nil {
+					t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+				}
+				return resp, err
+			})
+		})
+	}
+}
+
+func TestRepositoriesService_RemoveRequiredStatusChecksContexts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		branch  string
+		urlPath string
+	}{
+		{branch: "b", urlPath: "/repos/o/r/branches/b/protection/required_status_checks/contexts"},
+		{branch: "feat/branch-50%", urlPath: "/repos/o/r/branches/feat%2fbranch-50%25/protection/required_status_checks/contexts"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.branch, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+
+			mux.HandleFunc(test.urlPath, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				testBody(t, r, `{"contexts":["y"]}`+"\n")
+				fmt.Fprint(w, `["x"]`)
+			})
+
+			ctx := context.Background()
+			contexts, _, err := client.Repositories.RemoveRequiredStatusChecksContexts(ctx, "o", "r", test.branch, []string{"y"})
+			if err != nil {
+				t.Errorf("Repositories.RemoveRequiredStatusChecksContexts returned error: %v", err)
+			}
+
+			want := []string{"x"}
+			if !cmp.Equal(contexts, want) {
+				t.Errorf("Repositories.RemoveRequiredStatusChecksContexts returned %+v, want %+v", contexts, want)
+			}
+
+			const methodName = "RemoveRequiredStatusChecksContexts"
+			testBadOptions(t, methodName, func() (err error) {
+				_, _, err = client.Repositories.RemoveRequiredStatusChecksContexts(ctx, "\n", "\n", "\n", []string{"y"})
+				return err
+			})
+
+			testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+				got, resp, err := client.Repositories.RemoveRequiredStatusChecksContexts(ctx, "o", "r", test.branch, []string{"y"})
+				if got != nil {
+					t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+				}
+				return resp, err
+			})
+		})
+	}
+}
+
 func TestRepositoriesService_GetPullRequestReviewEnforcement(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -3396,7 +4071,7 @@ func TestRepositoriesService_ListAllTopics(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testHeader(t, r, "Accept", mediaTypeV3)
 		fmt.Fprint(w, `{"names":["go", "go-github", "github"]}`)
 	})
 
@@ -3432,7 +4107,7 @@ func TestRepositoriesService_ListAllTopics_emptyTopics(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testHeader(t, r, "Accept", mediaTypeV3)
 		fmt.Fprint(w, `{"names":[]}`)
 	})
 
@@ -3454,7 +4129,7 @@ func TestRepositoriesService_ReplaceAllTopics(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
-		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testHeader(t, r, "Accept", mediaTypeV3)
 		fmt.Fprint(w, `{"names":["go", "go-github", "github"]}`)
 	})
 
@@ -3490,7 +4165,7 @@ func TestRepositoriesService_ReplaceAllTopics_nilSlice(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
-		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testHeader(t, r, "Accept", mediaTypeV3)
 		testBody(t, r, `{"names":[]}`+"\n")
 		fmt.Fprint(w, `{"names":[]}`)
 	})
@@ -3513,7 +4188,7 @@ func TestRepositoriesService_ReplaceAllTopics_emptySlice(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
-		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testHeader(t, r, "Accept", mediaTypeV3)
 		testBody(t, r, `{"names":[]}`+"\n")
 		fmt.Fprint(w, `{"names":[]}`)
 	})
@@ -3530,6 +4205,134 @@ func TestRepositoriesService_ReplaceAllTopics_emptySlice(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_AddTopics(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypeV3)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"names":["go", "github"]}`)
+		case "PUT":
+			testBody(t, r, `{"names":["go","github","api"]}`+"\n")
+			fmt.Fprint(w, `{"names":["go", "github", "api"]}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PUT", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.AddTopics(ctx, "o", "r", "github", "api")
+	if err != nil {
+		t.Fatalf("Repositories.AddTopics returned error: %v", err)
+	}
+
+	want := []string{"go", "github", "api"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.AddTopics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddTopics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.AddTopics(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.AddTopics(ctx, "o", "r", "api")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_AddTopics_alreadyPresent(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"names":["go", "github"]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.AddTopics(ctx, "o", "r", "go")
+	if err != nil {
+		t.Fatalf("Repositories.AddTopics returned error: %v", err)
+	}
+
+	want := []string{"go", "github"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.AddTopics returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_RemoveTopics(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypeV3)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"names":["go", "go-github", "github"]}`)
+		case "PUT":
+			testBody(t, r, `{"names":["go","github"]}`+"\n")
+			fmt.Fprint(w, `{"names":["go", "github"]}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PUT", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.RemoveTopics(ctx, "o", "r", "go-github", "missing")
+	if err != nil {
+		t.Fatalf("Repositories.RemoveTopics returned error: %v", err)
+	}
+
+	want := []string{"go", "github"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.RemoveTopics returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RemoveTopics"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.RemoveTopics(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.RemoveTopics(ctx, "o", "r", "go")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_RemoveTopics_notPresent(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.RemoveTopics(ctx, "o", "r", "github")
+	if err != nil {
+		t.Fatalf("Repositories.RemoveTopics returned error: %v", err)
+	}
+
+	want := []string{"go"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.RemoveTopics returned %+v, want %+v", got, want)
+	}
+}
+
 func TestRepositoriesService_ListAppRestrictions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -4225,6 +5028,65 @@ func TestRepositoriesService_Dispatch(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_Dispatch_invalidEventType(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	ctx := context.Background()
+	for _, eventType := range []string{"", strings.Repeat("e", maxDispatchEventTypeLength+1)} {
+		_, _, err := client.Repositories.Dispatch(ctx, "o", "r", DispatchRequestOptions{EventType: eventType})
+		if err == nil {
+			t.Errorf("Repositories.Dispatch with event type %q returned nil error", eventType)
+		}
+	}
+}
+
+func TestDispatchTyped(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	type deployPayload struct {
+		Environment string `json:"environment"`
+		Ref         string `json:"ref"`
+		Force       bool   `json:"force,omitempty"`
+	}
+
+	mux.HandleFunc("/repos/o/r/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"event_type":"deploy","client_payload":{"environment":"prod","ref":"main"}}`+"\n")
+		fmt.Fprint(w, `{"owner":{"login":"a"}}`)
+	})
+
+	ctx := context.Background()
+	payload := deployPayload{Environment: "prod", Ref: "main"}
+	got, _, err := DispatchTyped(ctx, client.Repositories, "o", "r", "deploy", payload)
+	if err != nil {
+		t.Errorf("DispatchTyped returned error: %v", err)
+	}
+
+	want := &Repository{Owner: &User{Login: Ptr("a")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DispatchTyped returned %+v, want %+v", got, want)
+	}
+
+	if _, _, err := DispatchTyped(ctx, client.Repositories, "o", "r", "", payload); err == nil {
+		t.Error("DispatchTyped with empty event type returned nil error")
+	}
+
+	if _, _, err := DispatchTyped(ctx, client.Repositories, "o", "r", "deploy", make(chan int)); err == nil {
+		t.Error("DispatchTyped with unmarshalable payload returned nil error")
+	}
+
+	const methodName = "DispatchTyped"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := DispatchTyped(ctx, client.Repositories, "o", "r", "deploy", payload)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestAdvancedSecurity_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &AdvancedSecurity{}, "{}")
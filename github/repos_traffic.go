@@ -6,8 +6,12 @@
 package github
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 )
 
 // TrafficReferrer represent information about traffic from a referrer .
@@ -46,6 +50,32 @@ type TrafficClones struct {
 	Uniques *int           `json:"uniques,omitempty"`
 }
 
+// TrafficSummary represents the views and clones of a repository since a
+// point in time, as returned by RepositoriesService.GetTrafficSummary.
+//
+// Unique counts are the sum of the daily unique counts, so a visitor active
+// on several days is counted once per day.
+type TrafficSummary struct {
+	// Since is the start of the summarized period, after clamping it to the
+	// traffic data retained by GitHub.
+	Since        time.Time
+	Views        int
+	UniqueViews  int
+	Clones       int
+	UniqueClones int
+}
+
+// trafficRetention is how far back GitHub reports traffic data.
+const trafficRetention = 14 * 24 * time.Hour
+
+// TopContentReport represents the top referrers and popular paths of a
+// repository over the last 14 days, each sorted by count in descending
+// order.
+type TopContentReport struct {
+	Referrers []*TrafficReferrer
+	Paths     []*TrafficPath
+}
+
 // TrafficBreakdownOptions specifies the parameters to methods that support breakdown per day or week.
 // Can be one of: day, week. Default: day.
 type TrafficBreakdownOptions struct {
@@ -147,3 +177,154 @@ func (s *RepositoriesService) ListTrafficClones(ctx context.Context, owner, repo
 
 	return trafficClones, resp, nil
 }
+
+// MergeTrafficClones merges two clone traffic reports into one, so that a
+// history longer than the 14 days reported by ListTrafficClones can be kept
+// by merging each new report into the previous result. Buckets are unioned
+// by timestamp; for a timestamp present in both, the bucket from fresh is
+// used since it holds the most recent counts. The buckets are sorted by
+// timestamp and Count and Uniques are set to the sums over all buckets.
+// Neither argument is modified, and either may be nil.
+func MergeTrafficClones(existing, fresh *TrafficClones) *TrafficClones {
+	byTime := make(map[int64]*TrafficData)
+	for _, c := range []*TrafficClones{existing, fresh} {
+		if c == nil {
+			continue
+		}
+		for _, d := range c.Clones {
+			if d == nil {
+				continue
+			}
+			byTime[d.GetTimestamp().Unix()] = d
+		}
+	}
+
+	merged := &TrafficClones{Count: Ptr(0), Uniques: Ptr(0)}
+	for _, d := range byTime {
+		merged.Clones = append(merged.Clones, d)
+		*merged.Count += d.GetCount()
+		*merged.Uniques += d.GetUniques()
+	}
+	slices.SortFunc(merged.Clones, func(a, b *TrafficData) int {
+		return a.GetTimestamp().Compare(b.GetTimestamp().Time)
+	})
+
+	return merged
+}
+
+// GetTrafficSummary fetches the daily views and clones of a repository and
+// sums the buckets starting at or after since. GitHub only retains traffic
+// data for the last 14 days, so an earlier since is moved forward to the
+// start of the oldest day GitHub reports.
+//
+// GitHub API docs: https://docs.github.com/rest/metrics/traffic#get-page-views
+// GitHub API docs: https://docs.github.com/rest/metrics/traffic#get-repository-clones
+//
+//meta:operation GET /repos/{owner}/{repo}/traffic/clones
+//meta:operation GET /repos/{owner}/{repo}/traffic/views
+func (s *RepositoriesService) GetTrafficSummary(ctx context.Context, owner, repo string, since time.Time) (*TrafficSummary, *Response, error) {
+	return s.getTrafficSummary(ctx, owner, repo, since, time.Now())
+}
+
+// getTrafficSummary implements GetTrafficSummary, with now as the current
+// time used to clamp since to the retention period.
+func (s *RepositoriesService) getTrafficSummary(ctx context.Context, owner, repo string, since, now time.Time) (*TrafficSummary, *Response, error) {
+	if oldest := now.UTC().Add(-trafficRetention).Truncate(24 * time.Hour); since.Before(oldest) {
+		since = oldest
+	}
+
+	opts := &TrafficBreakdownOptions{Per: "day"}
+	views, resp, err := s.ListTrafficViews(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	clones, resp, err := s.ListTrafficClones(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	summary := &TrafficSummary{Since: since}
+	summary.Views, summary.UniqueViews = sumTrafficData(views.Views, since)
+	summary.Clones, summary.UniqueClones = sumTrafficData(clones.Clones, since)
+
+	return summary, resp, nil
+}
+
+// sumTrafficData returns the total count and uniques of the buckets in data
+// that start at or after since.
+func sumTrafficData(data []*TrafficData, since time.Time) (count, uniques int) {
+	for _, d := range data {
+		if d.GetTimestamp().Before(since) {
+			continue
+		}
+		count += d.GetCount()
+		uniques += d.GetUniques()
+	}
+	return count, uniques
+}
+
+// GetTopContentReport fetches the top referrers and popular paths of a
+// repository concurrently and returns them sorted by count in descending
+// order. The first error encountered cancels the other request and is
+// returned. On success, the returned Response is the one of the referrers
+// request.
+//
+// GitHub API docs: https://docs.github.com/rest/metrics/traffic#get-top-referral-paths
+// GitHub API docs: https://docs.github.com/rest/metrics/traffic#get-top-referral-sources
+//
+//meta:operation GET /repos/{owner}/{repo}/traffic/popular/paths
+//meta:operation GET /repos/{owner}/{repo}/traffic/popular/referrers
+func (s *RepositoriesService) GetTopContentReport(ctx context.Context, owner, repo string) (*TopContentReport, *Response, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		errResp  *Response
+		resp     *Response
+		report   = new(TopContentReport)
+	)
+	fail := func(r *Response, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr, errResp = err, r
+			cancel()
+		}
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		referrers, r, err := s.ListTrafficReferrers(ctx, owner, repo)
+		if err != nil {
+			fail(r, err)
+			return
+		}
+		slices.SortStableFunc(referrers, func(a, b *TrafficReferrer) int {
+			return cmp.Compare(b.GetCount(), a.GetCount())
+		})
+		report.Referrers, resp = referrers, r
+	}()
+	go func() {
+		defer wg.Done()
+		paths, r, err := s.ListTrafficPaths(ctx, owner, repo)
+		if err != nil {
+			fail(r, err)
+			return
+		}
+		slices.SortStableFunc(paths, func(a, b *TrafficPath) int {
+			return cmp.Compare(b.GetCount(), a.GetCount())
+		})
+		report.Paths = paths
+	}()
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, errResp, firstErr
+	}
+
+	return report, resp, nil
+}
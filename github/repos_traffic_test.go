@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -201,6 +202,202 @@ func TestRepositoriesService_ListTrafficClones(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_GetTrafficSummary(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	// The clock is fixed so that the expected summaries do not depend on
+	// when the test runs.
+	now := time.Date(2024, time.March, 15, 23, 59, 59, 0, time.UTC)
+	today := now.Truncate(24 * time.Hour)
+	// A week of daily buckets, oldest first, where day i has i+1 views and
+	// clones, and one unique visitor and cloner.
+	buckets := func() string {
+		var b strings.Builder
+		for i := 0; i < 7; i++ {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			fmt.Fprintf(&b, `{"timestamp":%q,"count":%v,"uniques":1}`, today.AddDate(0, 0, i-6).Format(time.RFC3339), i+1)
+		}
+		return b.String()
+	}()
+
+	mux.HandleFunc("/repos/o/r/traffic/views", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per": "day"})
+		fmt.Fprintf(w, `{"count":28,"uniques":3,"views":[%v]}`, buckets)
+	})
+	mux.HandleFunc("/repos/o/r/traffic/clones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per": "day"})
+		fmt.Fprintf(w, `{"count":28,"uniques":3,"clones":[%v]}`, buckets)
+	})
+
+	ctx := context.Background()
+
+	// The last three days hold 5, 6 and 7 views and clones.
+	since := today.AddDate(0, 0, -2)
+	got, _, err := client.Repositories.getTrafficSummary(ctx, "o", "r", since, now)
+	if err != nil {
+		t.Fatalf("Repositories.GetTrafficSummary returned error: %v", err)
+	}
+	want := &TrafficSummary{Since: since, Views: 18, UniqueViews: 3, Clones: 18, UniqueClones: 3}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetTrafficSummary returned %+v, want %+v", got, want)
+	}
+
+	// A since beyond the retention period is clamped and covers every bucket.
+	got, _, err = client.Repositories.getTrafficSummary(ctx, "o", "r", today.AddDate(-1, 0, 0), now)
+	if err != nil {
+		t.Fatalf("Repositories.GetTrafficSummary returned error: %v", err)
+	}
+	want = &TrafficSummary{Since: today.AddDate(0, 0, -14), Views: 28, UniqueViews: 7, Clones: 28, UniqueClones: 7}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetTrafficSummary returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetTrafficSummary"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetTrafficSummary(ctx, "\n", "\n", since)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetTrafficSummary(ctx, "o", "r", since)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetTopContentReport(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/traffic/popular/referrers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"referrer":"a.com","count":2,"uniques":1},
+			{"referrer":"b.com","count":10,"uniques":5},
+			{"referrer":"c.com","count":4,"uniques":2}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r/traffic/popular/paths", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"path":"/o/r/wiki","count":1,"uniques":1},
+			{"path":"/o/r","count":7,"uniques":3}
+		]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetTopContentReport(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetTopContentReport returned error: %v", err)
+	}
+
+	want := &TopContentReport{
+		Referrers: []*TrafficReferrer{
+			{Referrer: Ptr("b.com"), Count: Ptr(10), Uniques: Ptr(5)},
+			{Referrer: Ptr("c.com"), Count: Ptr(4), Uniques: Ptr(2)},
+			{Referrer: Ptr("a.com"), Count: Ptr(2), Uniques: Ptr(1)},
+		},
+		Paths: []*TrafficPath{
+			{Path: Ptr("/o/r"), Count: Ptr(7), Uniques: Ptr(3)},
+			{Path: Ptr("/o/r/wiki"), Count: Ptr(1), Uniques: Ptr(1)},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Repositories.GetTopContentReport returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetTopContentReport"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetTopContentReport(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetTopContentReport(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetTopContentReport_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/traffic/popular/referrers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/repos/o/r/traffic/popular/paths", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	got, resp, err := client.Repositories.GetTopContentReport(ctx, "o", "r")
+	if err == nil {
+		t.Fatal("Repositories.GetTopContentReport returned nil error, want error")
+	}
+	if got != nil {
+		t.Errorf("Repositories.GetTopContentReport returned %+v, want nil", got)
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Repositories.GetTopContentReport returned response %+v, want status 403", resp)
+	}
+}
+
+func TestMergeTrafficClones(t *testing.T) {
+	t.Parallel()
+	day := func(d int) *Timestamp {
+		return &Timestamp{time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)}
+	}
+	bucket := func(d, count, uniques int) *TrafficData {
+		return &TrafficData{Timestamp: day(d), Count: Ptr(count), Uniques: Ptr(uniques)}
+	}
+
+	// The windows overlap on days 3 and 4; day 4 was still in progress when
+	// existing was fetched.
+	existing := &TrafficClones{
+		Clones:  []*TrafficData{bucket(1, 5, 2), bucket(2, 3, 1), bucket(3, 4, 2), bucket(4, 1, 1)},
+		Count:   Ptr(13),
+		Uniques: Ptr(6),
+	}
+	fresh := &TrafficClones{
+		Clones:  []*TrafficData{bucket(5, 2, 2), bucket(3, 4, 2), bucket(4, 6, 3)},
+		Count:   Ptr(12),
+		Uniques: Ptr(7),
+	}
+
+	got := MergeTrafficClones(existing, fresh)
+	want := &TrafficClones{
+		Clones:  []*TrafficData{bucket(1, 5, 2), bucket(2, 3, 1), bucket(3, 4, 2), bucket(4, 6, 3), bucket(5, 2, 2)},
+		Count:   Ptr(20),
+		Uniques: Ptr(10),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("MergeTrafficClones returned %+v, want %+v", got, want)
+	}
+	if len(existing.Clones) != 4 || existing.GetCount() != 13 {
+		t.Errorf("MergeTrafficClones modified existing: %+v", existing)
+	}
+
+	got = MergeTrafficClones(nil, fresh)
+	want = &TrafficClones{
+		Clones:  []*TrafficData{bucket(3, 4, 2), bucket(4, 6, 3), bucket(5, 2, 2)},
+		Count:   Ptr(12),
+		Uniques: Ptr(7),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("MergeTrafficClones(nil, fresh) returned %+v, want %+v", got, want)
+	}
+}
+
 func TestTrafficReferrer_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &TrafficReferrer{}, "{}")
@@ -917,3 +917,58 @@ func TestRepositoryRule(t *testing.T) {
 		}
 	})
 }
+
+func TestRepositoryRuleset_zeroValueGetters(t *testing.T) {
+	t.Parallel()
+	var r RepositoryRuleset
+
+	if got := r.GetID(); got != 0 {
+		t.Errorf("GetID() = %v, want 0", got)
+	}
+	if got := r.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() = %q, want empty", got)
+	}
+	if got := r.GetTarget(); got != nil {
+		t.Errorf("GetTarget() = %v, want nil", got)
+	}
+	if got := r.GetSourceType(); got != nil {
+		t.Errorf("GetSourceType() = %v, want nil", got)
+	}
+	if got := r.GetCurrentUserCanBypass(); got != nil {
+		t.Errorf("GetCurrentUserCanBypass() = %v, want nil", got)
+	}
+	if got := r.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() = %v, want zero", got)
+	}
+	if got := r.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() = %v, want zero", got)
+	}
+
+	// Chained getters must not panic on nil intermediate values.
+	if got := r.GetLinks().GetSelf().GetHRef(); got != "" {
+		t.Errorf("GetLinks().GetSelf().GetHRef() = %q, want empty", got)
+	}
+	if got := r.GetConditions().GetRepositoryName().GetProtected(); got {
+		t.Errorf("GetConditions().GetRepositoryName().GetProtected() = %v, want false", got)
+	}
+	if got := r.GetConditions().GetRefName(); got != nil {
+		t.Errorf("GetConditions().GetRefName() = %v, want nil", got)
+	}
+	if got := r.GetRules().GetPullRequest().GetAutomaticCopilotCodeReviewEnabled(); got {
+		t.Errorf("GetRules().GetPullRequest().GetAutomaticCopilotCodeReviewEnabled() = %v, want false", got)
+	}
+	if got := r.GetRules().GetRequiredStatusChecks().GetDoNotEnforceOnCreate(); got {
+		t.Errorf("GetRules().GetRequiredStatusChecks().GetDoNotEnforceOnCreate() = %v, want false", got)
+	}
+
+	var a *BypassActor
+	if got := a.GetActorID(); got != 0 {
+		t.Errorf("GetActorID() = %v, want 0", got)
+	}
+	if got := a.GetActorType(); got != nil {
+		t.Errorf("GetActorType() = %v, want nil", got)
+	}
+	if got := a.GetBypassMode(); got != nil {
+		t.Errorf("GetBypassMode() = %v, want nil", got)
+	}
+}
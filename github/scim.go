@@ -124,6 +124,7 @@ func (s *SCIMService) ListSCIMProvisionedIdentities(ctx context.Context, org str
 	if err != nil {
 		return nil, nil, err
 	}
+	req.Header.Set("Accept", mediaTypeSCIM)
 
 	identities := new(SCIMProvisionedIdentities)
 	resp, err := s.client.Do(ctx, req, identities)
@@ -146,6 +147,8 @@ func (s *SCIMService) ProvisionAndInviteSCIMUser(ctx context.Context, org string
 	if err != nil {
 		return nil, nil, err
 	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+	req.Header.Set("Content-Type", mediaTypeSCIM)
 
 	user := new(SCIMUserAttributes)
 	resp, err := s.client.Do(ctx, req, user)
@@ -167,6 +170,7 @@ func (s *SCIMService) GetSCIMProvisioningInfoForUser(ctx context.Context, org, s
 	if err != nil {
 		return nil, nil, err
 	}
+	req.Header.Set("Accept", mediaTypeSCIM)
 
 	user := new(SCIMUserAttributes)
 	resp, err := s.client.Do(ctx, req, &user)
@@ -184,15 +188,12 @@ func (s *SCIMService) GetSCIMProvisioningInfoForUser(ctx context.Context, org, s
 //meta:operation PUT /scim/v2/organizations/{org}/Users/{scim_user_id}
 func (s *SCIMService) UpdateProvisionedOrgMembership(ctx context.Context, org, scimUserID string, opts *SCIMUserAttributes) (*Response, error) {
 	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", org, scimUserID)
-	u, err := addOptions(u, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+	req.Header.Set("Content-Type", mediaTypeSCIM)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -219,15 +220,12 @@ type UpdateAttributeForSCIMUserOperations struct {
 //meta:operation PATCH /scim/v2/organizations/{org}/Users/{scim_user_id}
 func (s *SCIMService) UpdateAttributeForSCIMUser(ctx context.Context, org, scimUserID string, opts *UpdateAttributeForSCIMUserOptions) (*Response, error) {
 	u := fmt.Sprintf("scim/v2/organizations/%v/Users/%v", org, scimUserID)
-	u, err := addOptions(u, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest("PATCH", u, nil)
+	req, err := s.client.NewRequest("PATCH", u, opts)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+	req.Header.Set("Content-Type", mediaTypeSCIM)
 
 	return s.client.Do(ctx, req, nil)
 }
@@ -243,6 +241,7 @@ func (s *SCIMService) DeleteSCIMUserFromOrg(ctx context.Context, org, scimUserID
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Accept", mediaTypeSCIM)
 
 	return s.client.Do(ctx, req, nil)
 }
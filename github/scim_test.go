@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -365,6 +366,9 @@ func TestSCIMService_UpdateProvisionedOrgMembership(t *testing.T) {
 
 	mux.HandleFunc("/scim/v2/organizations/o/Users/123", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testHeader(t, r, "Content-Type", mediaTypeSCIM)
+		testBody(t, r, `{"userName":"userName","name":{"givenName":"givenName","familyName":"familyName"},"emails":[{"value":"octocat@github.com"}],"externalId":"e"}`+"\n")
 		w.WriteHeader(http.StatusOK)
 	})
 
@@ -380,6 +384,7 @@ func TestSCIMService_UpdateProvisionedOrgMembership(t *testing.T) {
 				Value: "octocat@github.com",
 			},
 		},
+		ExternalID: Ptr("e"),
 	}
 	_, err := client.SCIM.UpdateProvisionedOrgMembership(ctx, "o", "123", opts)
 	if err != nil {
@@ -403,11 +408,20 @@ func TestSCIMService_UpdateAttributeForSCIMUser(t *testing.T) {
 
 	mux.HandleFunc("/scim/v2/organizations/o/Users/123", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testHeader(t, r, "Content-Type", mediaTypeSCIM)
+		testBody(t, r, `{"operations":{"op":"replace","path":"active","value":false}}`+"\n")
 		w.WriteHeader(http.StatusNoContent)
 	})
 
 	ctx := context.Background()
-	opts := &UpdateAttributeForSCIMUserOptions{}
+	opts := &UpdateAttributeForSCIMUserOptions{
+		Operations: UpdateAttributeForSCIMUserOperations{
+			Op:    "replace",
+			Path:  Ptr("active"),
+			Value: json.RawMessage(`false`),
+		},
+	}
 	_, err := client.SCIM.UpdateAttributeForSCIMUser(ctx, "o", "123", opts)
 	if err != nil {
 		t.Errorf("SCIM.UpdateAttributeForSCIMUser returned error: %v", err)
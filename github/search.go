@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	qs "github.com/google/go-querystring/query"
 )
@@ -57,6 +58,24 @@ type SearchOptions struct {
 	ListOptions
 }
 
+// SearchOrder is the order of sorted search results.
+type SearchOrder string
+
+// This is the set of orders of sorted search results.
+const (
+	SearchOrderAsc  SearchOrder = "asc"
+	SearchOrderDesc SearchOrder = "desc"
+)
+
+// CommitSearchSort is the field commit search results are sorted by.
+type CommitSearchSort string
+
+// This is the set of fields commit search results can be sorted by.
+const (
+	CommitSearchSortAuthorDate    CommitSearchSort = "author-date"
+	CommitSearchSortCommitterDate CommitSearchSort = "committer-date"
+)
+
 // Common search parameters.
 type searchParameters struct {
 	Query        string
@@ -85,6 +104,87 @@ func (s *SearchService) Repositories(ctx context.Context, query string, opts *Se
 	return result, resp, nil
 }
 
+// RepositoriesQuery searches repositories using a query built with
+// RepositorySearchQuery.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-repositories
+//
+//meta:operation GET /search/repositories
+func (s *SearchService) RepositoriesQuery(ctx context.Context, q *RepositorySearchQuery, opts *SearchOptions) (*RepositoriesSearchResult, *Response, error) {
+	return s.Repositories(ctx, q.String(), opts)
+}
+
+// RepositorySearchQuery builds a query string for SearchService.Repositories.
+// The zero value is an empty query, and each method appends a keyword or
+// qualifier and returns the query so calls can be chained:
+//
+//	q := new(github.RepositorySearchQuery).Keywords("http").Language("go").StarsGreaterThan(100)
+//	result, _, err := client.Search.RepositoriesQuery(ctx, q, nil)
+//
+// Values containing spaces or quotes are quoted for you.
+//
+// GitHub API docs: https://docs.github.com/search-github/searching-on-github/searching-for-repositories
+type RepositorySearchQuery struct {
+	searchQuery
+}
+
+// String returns the query string.
+func (q *RepositorySearchQuery) String() string {
+	if q == nil {
+		return ""
+	}
+	return q.query()
+}
+
+// Keywords adds search keywords. Keywords that contain spaces or look like
+// qualifiers are quoted so they are matched literally.
+func (q *RepositorySearchQuery) Keywords(keywords ...string) *RepositorySearchQuery {
+	q.addKeywords(keywords)
+	return q
+}
+
+// Language restricts results to repositories written in language.
+func (q *RepositorySearchQuery) Language(language string) *RepositorySearchQuery {
+	q.add("language", language)
+	return q
+}
+
+// StarsGreaterThan restricts results to repositories with more than n stars.
+func (q *RepositorySearchQuery) StarsGreaterThan(n int) *RepositorySearchQuery {
+	q.add("stars", ">"+strconv.Itoa(n))
+	return q
+}
+
+// PushedAfter restricts results to repositories pushed to after t.
+func (q *RepositorySearchQuery) PushedAfter(t time.Time) *RepositorySearchQuery {
+	q.add("pushed", ">"+formatSearchTime(t))
+	return q
+}
+
+// User restricts results to repositories owned by the user login.
+func (q *RepositorySearchQuery) User(login string) *RepositorySearchQuery {
+	q.add("user", login)
+	return q
+}
+
+// Org restricts results to repositories owned by the organization org.
+func (q *RepositorySearchQuery) Org(org string) *RepositorySearchQuery {
+	q.add("org", org)
+	return q
+}
+
+// Topic restricts results to repositories classified with topic.
+func (q *RepositorySearchQuery) Topic(topic string) *RepositorySearchQuery {
+	q.add("topic", topic)
+	return q
+}
+
+// Archived restricts results to archived or unarchived repositories.
+func (q *RepositorySearchQuery) Archived(archived bool) *RepositorySearchQuery {
+	q.add("archived", strconv.FormatBool(archived))
+	return q
+}
+
 // TopicsSearchResult represents the result of a topics search.
 type TopicsSearchResult struct {
 	Total             *int           `json:"total_count,omitempty"`
@@ -159,6 +259,119 @@ func (s *SearchService) Commits(ctx context.Context, query string, opts *SearchO
 	return result, resp, nil
 }
 
+// CommitsQuery searches commits using a query built with CommitSearchQuery.
+// The sort and order set with CommitSearchQuery.Sort and
+// CommitSearchQuery.Order take precedence over opts.Sort and opts.Order, and
+// an unknown sort or order is rejected before any request is made.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-commits
+//
+//meta:operation GET /search/commits
+func (s *SearchService) CommitsQuery(ctx context.Context, q *CommitSearchQuery, opts *SearchOptions) (*CommitsSearchResult, *Response, error) {
+	o := new(SearchOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	if q != nil {
+		if q.sort != "" {
+			o.Sort = string(q.sort)
+		}
+		if q.order != "" {
+			o.Order = string(q.order)
+		}
+	}
+
+	switch CommitSearchSort(o.Sort) {
+	case "", CommitSearchSortAuthorDate, CommitSearchSortCommitterDate:
+	default:
+		return nil, nil, fmt.Errorf("invalid commit search sort %q", o.Sort)
+	}
+	switch SearchOrder(o.Order) {
+	case "", SearchOrderAsc, SearchOrderDesc:
+	default:
+		return nil, nil, fmt.Errorf("invalid search order %q", o.Order)
+	}
+
+	return s.Commits(ctx, q.String(), o)
+}
+
+// CommitSearchQuery builds a query string for SearchService.Commits. It is
+// used like RepositorySearchQuery:
+//
+//	q := new(github.CommitSearchQuery).Keywords("fix").Repo("o/r").Merge(false).Sort(github.CommitSearchSortAuthorDate)
+//	result, _, err := client.Search.CommitsQuery(ctx, q, nil)
+//
+// GitHub API docs: https://docs.github.com/search-github/searching-on-github/searching-commits
+type CommitSearchQuery struct {
+	searchQuery
+
+	sort  CommitSearchSort
+	order SearchOrder
+}
+
+// String returns the query string.
+func (q *CommitSearchQuery) String() string {
+	if q == nil {
+		return ""
+	}
+	return q.query()
+}
+
+// Keywords adds search keywords. Keywords that contain spaces or look like
+// qualifiers are quoted so they are matched literally.
+func (q *CommitSearchQuery) Keywords(keywords ...string) *CommitSearchQuery {
+	q.addKeywords(keywords)
+	return q
+}
+
+// AuthorDate restricts results to commits authored between from and to,
+// inclusive. A zero from or to leaves that end of the range open.
+func (q *CommitSearchQuery) AuthorDate(from, to time.Time) *CommitSearchQuery {
+	q.addDateRange("author-date", from, to)
+	return q
+}
+
+// CommitterDate restricts results to commits committed between from and to,
+// inclusive. A zero from or to leaves that end of the range open.
+func (q *CommitSearchQuery) CommitterDate(from, to time.Time) *CommitSearchQuery {
+	q.addDateRange("committer-date", from, to)
+	return q
+}
+
+// Repo restricts results to commits in the repository fullName, in the
+// "owner/repo" form.
+func (q *CommitSearchQuery) Repo(fullName string) *CommitSearchQuery {
+	q.add("repo", fullName)
+	return q
+}
+
+// Author restricts results to commits authored by the user login.
+func (q *CommitSearchQuery) Author(login string) *CommitSearchQuery {
+	q.add("author", login)
+	return q
+}
+
+// Merge restricts results to merge commits, or excludes them if merge is
+// false.
+func (q *CommitSearchQuery) Merge(merge bool) *CommitSearchQuery {
+	q.add("merge", strconv.FormatBool(merge))
+	return q
+}
+
+// Sort sorts the results by sort instead of by best match. It only applies
+// when searching with SearchService.CommitsQuery.
+func (q *CommitSearchQuery) Sort(sort CommitSearchSort) *CommitSearchQuery {
+	q.sort = sort
+	return q
+}
+
+// Order sets the order of sorted results. It only applies when searching
+// with SearchService.CommitsQuery.
+func (q *CommitSearchQuery) Order(order SearchOrder) *CommitSearchQuery {
+	q.order = order
+	return q
+}
+
 // IssuesSearchResult represents the result of an issues search.
 type IssuesSearchResult struct {
 	Total             *int     `json:"total_count,omitempty"`
@@ -203,6 +416,82 @@ func (s *SearchService) Users(ctx context.Context, query string, opts *SearchOpt
 	return result, resp, nil
 }
 
+// UsersQuery searches users using a query built with UserSearchQuery.
+//
+// GitHub API docs: https://docs.github.com/rest/search/search#search-users
+//
+//meta:operation GET /search/users
+func (s *SearchService) UsersQuery(ctx context.Context, q *UserSearchQuery, opts *SearchOptions) (*UsersSearchResult, *Response, error) {
+	return s.Users(ctx, q.String(), opts)
+}
+
+// UserSearchQuery builds a query string for SearchService.Users. It is used
+// like RepositorySearchQuery:
+//
+//	q := new(github.UserSearchQuery).Type("user").Location("San Francisco").FollowersGreaterThan(100)
+//	result, _, err := client.Search.UsersQuery(ctx, q, nil)
+//
+// GitHub API docs: https://docs.github.com/search-github/searching-on-github/searching-users
+type UserSearchQuery struct {
+	searchQuery
+}
+
+// String returns the query string.
+func (q *UserSearchQuery) String() string {
+	if q == nil {
+		return ""
+	}
+	return q.query()
+}
+
+// Keywords adds search keywords. Keywords that contain spaces or look like
+// qualifiers are quoted so they are matched literally.
+func (q *UserSearchQuery) Keywords(keywords ...string) *UserSearchQuery {
+	q.addKeywords(keywords)
+	return q
+}
+
+// Type restricts results to personal accounts or organizations.
+// Possible values are: "user", "org".
+func (q *UserSearchQuery) Type(accountType string) *UserSearchQuery {
+	q.add("type", accountType)
+	return q
+}
+
+// FollowersGreaterThan restricts results to accounts with more than n
+// followers.
+func (q *UserSearchQuery) FollowersGreaterThan(n int) *UserSearchQuery {
+	q.add("followers", ">"+strconv.Itoa(n))
+	return q
+}
+
+// ReposGreaterThan restricts results to accounts with more than n
+// repositories.
+func (q *UserSearchQuery) ReposGreaterThan(n int) *UserSearchQuery {
+	q.add("repos", ">"+strconv.Itoa(n))
+	return q
+}
+
+// Location restricts results to accounts whose profile location matches
+// location.
+func (q *UserSearchQuery) Location(location string) *UserSearchQuery {
+	q.add("location", location)
+	return q
+}
+
+// Language restricts results to accounts with repositories mostly written in
+// language.
+func (q *UserSearchQuery) Language(language string) *UserSearchQuery {
+	q.add("language", language)
+	return q
+}
+
+// CreatedAfter restricts results to accounts created after t.
+func (q *UserSearchQuery) CreatedAfter(t time.Time) *UserSearchQuery {
+	q.add("created", ">"+formatSearchTime(t))
+	return q
+}
+
 // Match represents a single text match.
 type Match struct {
 	Text    *string `json:"text,omitempty"`
@@ -295,6 +584,71 @@ func (s *SearchService) Labels(ctx context.Context, repoID int64, query string,
 	return result, resp, nil
 }
 
+// searchQuery accumulates the keywords and qualifiers of a search query.
+// It is embedded in the typed query builders.
+type searchQuery struct {
+	terms []string
+}
+
+func (q *searchQuery) query() string {
+	return strings.Join(q.terms, " ")
+}
+
+func (q *searchQuery) addKeywords(keywords []string) {
+	for _, k := range keywords {
+		if k == "" {
+			continue
+		}
+		if strings.Contains(k, ":") {
+			q.terms = append(q.terms, quoteSearchValue(k))
+			continue
+		}
+		q.terms = append(q.terms, quoteSearchValueIfNeeded(k))
+	}
+}
+
+func (q *searchQuery) add(qualifier, value string) {
+	q.terms = append(q.terms, qualifier+":"+quoteSearchValueIfNeeded(value))
+}
+
+// addDateRange adds a date range qualifier. A zero from or to leaves that
+// end of the range open; if both are zero, nothing is added.
+func (q *searchQuery) addDateRange(qualifier string, from, to time.Time) {
+	switch {
+	case from.IsZero() && to.IsZero():
+	case to.IsZero():
+		q.add(qualifier, ">="+formatSearchTime(from))
+	case from.IsZero():
+		q.add(qualifier, "<="+formatSearchTime(to))
+	default:
+		q.add(qualifier, formatSearchTime(from)+".."+formatSearchTime(to))
+	}
+}
+
+// quoteSearchValueIfNeeded quotes v if it is empty or contains whitespace or
+// quotes, which would otherwise split it into several search terms.
+func quoteSearchValueIfNeeded(v string) string {
+	if v == "" || strings.ContainsAny(v, " \t\r\n\"") {
+		return quoteSearchValue(v)
+	}
+	return v
+}
+
+func quoteSearchValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+	return `"` + v + `"`
+}
+
+// formatSearchTime formats t for use in a search date qualifier. Times at
+// midnight are formatted as a date, others in ISO 8601 format.
+func formatSearchTime(t time.Time) string {
+	if h, m, s := t.Clock(); h == 0 && m == 0 && s == 0 && t.Nanosecond() == 0 {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format(time.RFC3339)
+}
+
 // Helper function that executes search queries against different
 // GitHub search types (repositories, commits, code, issues, users, labels)
 //
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -141,6 +142,95 @@ func TestSearchService_RepositoriesTextMatch(t *testing.T) {
 	}
 }
 
+func TestSearchService_RepositoriesQuery(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"q":    "http language:go stars:>100",
+			"sort": "stars",
+		})
+
+		fmt.Fprint(w, `{"total_count": 1, "incomplete_results": false, "items": [{"id":1}]}`)
+	})
+
+	q := new(RepositorySearchQuery).Keywords("http").Language("go").StarsGreaterThan(100)
+	opts := &SearchOptions{Sort: "stars"}
+	ctx := context.Background()
+	result, _, err := client.Search.RepositoriesQuery(ctx, q, opts)
+	if err != nil {
+		t.Errorf("Search.RepositoriesQuery returned error: %v", err)
+	}
+
+	want := &RepositoriesSearchResult{
+		Total:             Ptr(1),
+		IncompleteResults: Ptr(false),
+		Repositories:      []*Repository{{ID: Ptr(int64(1))}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Search.RepositoriesQuery returned %+v, want %+v", result, want)
+	}
+
+	const methodName = "RepositoriesQuery"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.RepositoriesQuery(ctx, q, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositorySearchQuery_String(t *testing.T) {
+	t.Parallel()
+	pushed := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		q    *RepositorySearchQuery
+		want string
+	}{
+		{name: "nil", q: nil, want: ""},
+		{name: "empty", q: &RepositorySearchQuery{}, want: ""},
+		{
+			name: "qualifiers",
+			q: new(RepositorySearchQuery).
+				Keywords("cli", "").
+				Language("go").
+				StarsGreaterThan(100).
+				PushedAfter(pushed).
+				User("octocat").
+				Archived(false),
+			want: "cli language:go stars:>100 pushed:>2024-03-01 user:octocat archived:false",
+		},
+		{
+			name: "pushed after time of day",
+			q:    new(RepositorySearchQuery).PushedAfter(pushed.Add(90 * time.Minute)),
+			want: "pushed:>2024-03-01T01:30:00Z",
+		},
+		{
+			name: "quoted values",
+			q:    new(RepositorySearchQuery).Language("Visual Basic").Topic(`say "hi"`).Org("o"),
+			want: `language:"Visual Basic" topic:"say \"hi\"" org:o`,
+		},
+		{
+			name: "escaped keywords",
+			q:    new(RepositorySearchQuery).Keywords("user:octocat", "hello world"),
+			want: `"user:octocat" "hello world"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.q.String(); got != tt.want {
+				t.Errorf("RepositorySearchQuery.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSearchService_Topics(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -231,6 +321,122 @@ func TestSearchService_Commits_coverage(t *testing.T) {
 	})
 }
 
+func TestSearchService_CommitsQuery(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeCommitSearchPreview)
+		testFormValues(t, r, values{
+			"q":     "fix repo:o/r merge:false",
+			"sort":  "author-date",
+			"order": "asc",
+		})
+
+		fmt.Fprint(w, `{"total_count": 1, "incomplete_results": false, "items": [{"sha":"s"}]}`)
+	})
+
+	q := new(CommitSearchQuery).Keywords("fix").Repo("o/r").Merge(false).Sort(CommitSearchSortAuthorDate).Order(SearchOrderAsc)
+	opts := &SearchOptions{Sort: "committer-date", Order: "desc"}
+	ctx := context.Background()
+	result, _, err := client.Search.CommitsQuery(ctx, q, opts)
+	if err != nil {
+		t.Errorf("Search.CommitsQuery returned error: %v", err)
+	}
+
+	want := &CommitsSearchResult{
+		Total:             Ptr(1),
+		IncompleteResults: Ptr(false),
+		Commits:           []*CommitResult{{SHA: Ptr("s")}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Search.CommitsQuery returned %+v, want %+v", result, want)
+	}
+
+	const methodName = "CommitsQuery"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.CommitsQuery(ctx, q, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSearchService_CommitsQuery_invalidSort(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/commits", func(http.ResponseWriter, *http.Request) {
+		t.Error("Search.CommitsQuery sent a request with an invalid sort or order")
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		q    *CommitSearchQuery
+		opts *SearchOptions
+	}{
+		{name: "query sort", q: new(CommitSearchQuery).Sort("stars")},
+		{name: "query order", q: new(CommitSearchQuery).Order("up")},
+		{name: "options sort", q: new(CommitSearchQuery), opts: &SearchOptions{Sort: "stars"}},
+		{name: "options order", q: new(CommitSearchQuery), opts: &SearchOptions{Order: "up"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, _, err := client.Search.CommitsQuery(ctx, tt.q, tt.opts); err == nil {
+				t.Error("Search.CommitsQuery returned nil error, want an error")
+			}
+		})
+	}
+}
+
+func TestCommitSearchQuery_String(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		q    *CommitSearchQuery
+		want string
+	}{
+		{name: "nil", q: nil, want: ""},
+		{name: "empty", q: &CommitSearchQuery{}, want: ""},
+		{
+			name: "qualifiers",
+			q: new(CommitSearchQuery).
+				Keywords("fix bug").
+				Repo("o/r").
+				Author("octocat").
+				Merge(true).
+				AuthorDate(from, to),
+			want: `"fix bug" repo:o/r author:octocat merge:true author-date:2024-01-01..2024-01-31`,
+		},
+		{
+			name: "open date ranges",
+			q:    new(CommitSearchQuery).CommitterDate(from, time.Time{}).AuthorDate(time.Time{}, to.Add(time.Hour)),
+			want: "committer-date:>=2024-01-01 author-date:<=2024-01-31T01:00:00Z",
+		},
+		{
+			name: "unbounded date range",
+			q:    new(CommitSearchQuery).AuthorDate(time.Time{}, time.Time{}),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.q.String(); got != tt.want {
+				t.Errorf("CommitSearchQuery.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSearchService_Issues(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -402,6 +608,85 @@ func TestSearchService_Users_coverage(t *testing.T) {
 	})
 }
 
+func TestSearchService_UsersQuery(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/search/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"q":    `type:org location:"New York"`,
+			"sort": "followers",
+		})
+
+		fmt.Fprint(w, `{"total_count": 1, "incomplete_results": false, "items": [{"id":1}]}`)
+	})
+
+	q := new(UserSearchQuery).Type("org").Location("New York")
+	opts := &SearchOptions{Sort: "followers"}
+	ctx := context.Background()
+	result, _, err := client.Search.UsersQuery(ctx, q, opts)
+	if err != nil {
+		t.Errorf("Search.UsersQuery returned error: %v", err)
+	}
+
+	want := &UsersSearchResult{
+		Total:             Ptr(1),
+		IncompleteResults: Ptr(false),
+		Users:             []*User{{ID: Ptr(int64(1))}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Search.UsersQuery returned %+v, want %+v", result, want)
+	}
+
+	const methodName = "UsersQuery"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.UsersQuery(ctx, q, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUserSearchQuery_String(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		q    *UserSearchQuery
+		want string
+	}{
+		{name: "nil", q: nil, want: ""},
+		{name: "empty", q: &UserSearchQuery{}, want: ""},
+		{
+			name: "qualifiers",
+			q: new(UserSearchQuery).
+				Keywords("tom").
+				Type("user").
+				FollowersGreaterThan(100).
+				ReposGreaterThan(10).
+				Language("go").
+				CreatedAfter(created),
+			want: "tom type:user followers:>100 repos:>10 language:go created:>2020-06-01",
+		},
+		{
+			name: "quoted location",
+			q:    new(UserSearchQuery).Location("San Francisco").Location("Berlin"),
+			want: `location:"San Francisco" location:Berlin`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.q.String(); got != tt.want {
+				t.Errorf("UserSearchQuery.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSearchService_Code(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
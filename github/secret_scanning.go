@@ -51,17 +51,32 @@ type SecretScanningAlertLocation struct {
 }
 
 // SecretScanningAlertLocationDetails represents the location details for a secret scanning alert.
+// Which fields are set depends on the Type of the SecretScanningAlertLocation:
+// "commit" and "wiki_commit" locations have the file and commit fields,
+// while the other types only have the URL field named after the type, such
+// as IssueCommentURL for "issue_comment".
 type SecretScanningAlertLocationDetails struct {
-	Path                  *string `json:"path,omitempty"`
-	Startline             *int    `json:"start_line,omitempty"`
-	EndLine               *int    `json:"end_line,omitempty"`
-	StartColumn           *int    `json:"start_column,omitempty"`
-	EndColumn             *int    `json:"end_column,omitempty"`
-	BlobSHA               *string `json:"blob_sha,omitempty"`
-	BlobURL               *string `json:"blob_url,omitempty"`
-	CommitSHA             *string `json:"commit_sha,omitempty"`
-	CommitURL             *string `json:"commit_url,omitempty"`
-	PullRequestCommentURL *string `json:"pull_request_comment_url,omitempty"`
+	Path                        *string `json:"path,omitempty"`
+	Startline                   *int    `json:"start_line,omitempty"`
+	EndLine                     *int    `json:"end_line,omitempty"`
+	StartColumn                 *int    `json:"start_column,omitempty"`
+	EndColumn                   *int    `json:"end_column,omitempty"`
+	BlobSHA                     *string `json:"blob_sha,omitempty"`
+	BlobURL                     *string `json:"blob_url,omitempty"`
+	CommitSHA                   *string `json:"commit_sha,omitempty"`
+	CommitURL                   *string `json:"commit_url,omitempty"`
+	PageURL                     *string `json:"page_url,omitempty"`
+	IssueTitleURL               *string `json:"issue_title_url,omitempty"`
+	IssueBodyURL                *string `json:"issue_body_url,omitempty"`
+	IssueCommentURL             *string `json:"issue_comment_url,omitempty"`
+	DiscussionTitleURL          *string `json:"discussion_title_url,omitempty"`
+	DiscussionBodyURL           *string `json:"discussion_body_url,omitempty"`
+	DiscussionCommentURL        *string `json:"discussion_comment_url,omitempty"`
+	PullRequestTitleURL         *string `json:"pull_request_title_url,omitempty"`
+	PullRequestBodyURL          *string `json:"pull_request_body_url,omitempty"`
+	PullRequestCommentURL       *string `json:"pull_request_comment_url,omitempty"`
+	PullRequestReviewURL        *string `json:"pull_request_review_url,omitempty"`
+	PullRequestReviewCommentURL *string `json:"pull_request_review_comment_url,omitempty"`
 }
 
 // SecretScanningAlertListOptions specifies optional parameters to the SecretScanningService.ListAlertsForEnterprise method.
@@ -283,3 +298,25 @@ func (s *SecretScanningService) ListLocationsForAlert(ctx context.Context, owner
 
 	return locations, resp, nil
 }
+
+// ListAllLocationsForAlert lists the locations of a secret scanning alert,
+// following pagination until all of them have been fetched.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-locations-for-a-secret-scanning-alert
+//
+//meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations
+func (s *SecretScanningService) ListAllLocationsForAlert(ctx context.Context, owner, repo string, number int64) ([]*SecretScanningAlertLocation, *Response, error) {
+	var all []*SecretScanningAlertLocation
+	opts := &ListOptions{PerPage: 100}
+	for {
+		locations, resp, err := s.ListLocationsForAlert(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, locations...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -488,6 +488,78 @@ func TestSecretScanningService_ListLocationsForAlert(t *testing.T) {
 	})
 }
 
+func TestSecretScanningService_ListAllLocationsForAlert(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api-v3/repos/o/r/secret-scanning/alerts/1/locations?per_page=100&page=2>; rel="next"`, serverURL))
+			fmt.Fprint(w, `[{
+				"type": "commit",
+				"details": {
+					"path": "/example/secrets.txt",
+					"start_line": 1,
+					"end_line": 1,
+					"blob_sha": "af5626b4a114abcb82d63db7c8082c3c4756e51b",
+					"commit_sha": "f14d7debf9775f957cf4f1e8176da0786431f72b"
+				}
+			}]`)
+		case "2":
+			fmt.Fprint(w, `[{
+				"type": "issue_comment",
+				"details": {
+					"issue_comment_url": "https://api.github.com/repos/o/r/issues/comments/1081119402"
+				}
+			}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	locations, _, err := client.SecretScanning.ListAllLocationsForAlert(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAllLocationsForAlert returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlertLocation{
+		{
+			Type: Ptr("commit"),
+			Details: &SecretScanningAlertLocationDetails{
+				Path:      Ptr("/example/secrets.txt"),
+				Startline: Ptr(1),
+				EndLine:   Ptr(1),
+				BlobSHA:   Ptr("af5626b4a114abcb82d63db7c8082c3c4756e51b"),
+				CommitSHA: Ptr("f14d7debf9775f957cf4f1e8176da0786431f72b"),
+			},
+		},
+		{
+			Type: Ptr("issue_comment"),
+			Details: &SecretScanningAlertLocationDetails{
+				IssueCommentURL: Ptr("https://api.github.com/repos/o/r/issues/comments/1081119402"),
+			},
+		},
+	}
+	if !cmp.Equal(locations, want) {
+		t.Errorf("SecretScanning.ListAllLocationsForAlert returned %+v, want %+v", locations, want)
+	}
+
+	const methodName = "ListAllLocationsForAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListAllLocationsForAlert(ctx, "\n", "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.SecretScanning.ListAllLocationsForAlert(ctx, "o", "r", 1)
+		return resp, err
+	})
+}
+
 func TestSecretScanningAlert_Marshal(t *testing.T) {
 	t.Parallel()
 	testJSONMarshal(t, &SecretScanningAlert{}, `{}`)
@@ -32,6 +32,27 @@ type RepoAdvisoryCreditDetailed struct {
 	State *string `json:"state,omitempty"`
 }
 
+// RepositorySecurityAdvisory represents the writable fields of a repository
+// security advisory, used to create or update one.
+type RepositorySecurityAdvisory struct {
+	Summary         *string                  `json:"summary,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
+	Credits         []*RepoAdvisoryCredit    `json:"credits,omitempty"`
+	// Severity is one of: critical, high, medium, low. It cannot be set
+	// together with CVSSVector.
+	Severity   *string `json:"severity,omitempty"`
+	CVSSVector *string `json:"cvss_vector_string,omitempty"`
+
+	// State, CollaboratingUsers and CollaboratingTeams can only be set when
+	// updating an advisory. State is one of: published, closed, draft.
+	State              *string  `json:"state,omitempty"`
+	CollaboratingUsers []string `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []string `json:"collaborating_teams,omitempty"`
+}
+
 // ListRepositorySecurityAdvisoriesOptions specifies the optional parameters to list the repository security advisories.
 type ListRepositorySecurityAdvisoriesOptions struct {
 	ListCursorOptions
@@ -231,6 +252,74 @@ func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context
 	return advisories, resp, nil
 }
 
+// CreateRepositoryAdvisory creates a draft security advisory in a repository.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#create-a-repository-security-advisory
+//
+//meta:operation POST /repos/{owner}/{repo}/security-advisories
+func (s *SecurityAdvisoriesService) CreateRepositoryAdvisory(ctx context.Context, owner, repo string, advisory *RepositorySecurityAdvisory) (*SecurityAdvisory, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+
+	req, err := s.client.NewRequest("POST", url, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// GetRepositoryAdvisory gets a repository security advisory.
+// The ghsaID is the GitHub Security Advisory identifier of the advisory.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#get-a-repository-security-advisory
+//
+//meta:operation GET /repos/{owner}/{repo}/security-advisories/{ghsa_id}
+func (s *SecurityAdvisoriesService) GetRepositoryAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UpdateRepositoryAdvisory updates a repository security advisory.
+// The ghsaID is the GitHub Security Advisory identifier of the advisory.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#update-a-repository-security-advisory
+//
+//meta:operation PATCH /repos/{owner}/{repo}/security-advisories/{ghsa_id}
+func (s *SecurityAdvisoriesService) UpdateRepositoryAdvisory(ctx context.Context, owner, repo, ghsaID string, advisory *RepositorySecurityAdvisory) (*SecurityAdvisory, *Response, error) {
+	url := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("PATCH", url, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
 // ListGlobalSecurityAdvisories lists all global security advisories.
 //
 // GitHub API docs: https://docs.github.com/rest/security-advisories/global-advisories#list-global-security-advisories
@@ -784,6 +784,133 @@ func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T
 	})
 }
 
+func TestSecurityAdvisoriesService_CreateRepositoryAdvisory(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &RepositorySecurityAdvisory{
+		Summary:     Ptr("s"),
+		Description: Ptr("d"),
+		Vulnerabilities: []*AdvisoryVulnerability{
+			{
+				Package:                &VulnerabilityPackage{Ecosystem: Ptr("go"), Name: Ptr("p")},
+				VulnerableVersionRange: Ptr("< 1.0.1"),
+				PatchedVersions:        Ptr("1.0.1"),
+			},
+		},
+		CVSSVector: Ptr("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"summary":"s","description":"d","vulnerabilities":[{"package":{"ecosystem":"go","name":"p"},"vulnerable_version_range":"< 1.0.1","patched_versions":"1.0.1"}],"cvss_vector_string":"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-abcd-1234-efgh","summary":"s","state":"draft"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.CreateRepositoryAdvisory(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateRepositoryAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: Ptr("GHSA-abcd-1234-efgh"), Summary: Ptr("s"), State: Ptr("draft")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateRepositoryAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "CreateRepositoryAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateRepositoryAdvisory(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateRepositoryAdvisory(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_GetRepositoryAdvisory(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-abcd-1234-efgh","cve_id":"CVE-2050-00000","cvss":{"vector_string":"v"}}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetRepositoryAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetRepositoryAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID: Ptr("GHSA-abcd-1234-efgh"),
+		CVEID:  Ptr("CVE-2050-00000"),
+		CVSS:   &AdvisoryCVSS{VectorString: Ptr("v")},
+	}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetRepositoryAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetRepositoryAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetRepositoryAdvisory(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetRepositoryAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_UpdateRepositoryAdvisory(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &RepositorySecurityAdvisory{Severity: Ptr("high"), State: Ptr("published")}
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-abcd-1234-efgh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"severity":"high","state":"published"}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-abcd-1234-efgh","severity":"high","state":"published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.UpdateRepositoryAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.UpdateRepositoryAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: Ptr("GHSA-abcd-1234-efgh"), Severity: Ptr("high"), State: Ptr("published")}
+	if !cmp.Equal(advisory, want) {
+		t.Errorf("SecurityAdvisories.UpdateRepositoryAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "UpdateRepositoryAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.UpdateRepositoryAdvisory(ctx, "\n", "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.UpdateRepositoryAdvisory(ctx, "o", "r", "GHSA-abcd-1234-efgh", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestListGlobalSecurityAdvisories(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -970,6 +1097,50 @@ func TestListGlobalSecurityAdvisories(t *testing.T) {
 	})
 }
 
+func TestListGlobalSecurityAdvisories_filters(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ghsa_id":      "GHSA-xoxo-1234-xoxo",
+			"type":         "reviewed",
+			"cve_id":       "CVE-xoxo-1234",
+			"ecosystem":    "npm",
+			"severity":     "high",
+			"cwes":         "79",
+			"is_withdrawn": "false",
+			"affects":      "lodash@4.17.15",
+			"after":        "Y3Vyc29y",
+			"per_page":     "50",
+		})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-xoxo-1234-xoxo"}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListGlobalSecurityAdvisoriesOptions{
+		ListCursorOptions: ListCursorOptions{After: "Y3Vyc29y", PerPage: 50},
+		GHSAID:            Ptr("GHSA-xoxo-1234-xoxo"),
+		Type:              Ptr("reviewed"),
+		CVEID:             Ptr("CVE-xoxo-1234"),
+		Ecosystem:         Ptr("npm"),
+		Severity:          Ptr("high"),
+		CWEs:              []string{"79"},
+		IsWithdrawn:       Ptr(false),
+		Affects:           Ptr("lodash@4.17.15"),
+	}
+	advisories, _, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*GlobalSecurityAdvisory{{SecurityAdvisory: SecurityAdvisory{GHSAID: Ptr("GHSA-xoxo-1234-xoxo")}}}
+	if !cmp.Equal(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+}
+
 func TestGetGlobalSecurityAdvisories(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -386,6 +386,53 @@ func (s *TeamsService) ListChildTeamsByParentSlug(ctx context.Context, org, slug
 	return teams, resp, nil
 }
 
+// ListAllChildTeams lists the whole subtree of child teams below the team
+// given by slug, in breadth-first order. Each level is listed in the order
+// returned by GitHub, and every team keeps the Parent reference returned by
+// the API. A team already seen is not visited again, so a malformed hierarchy
+// cannot cause an endless loop.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-child-teams
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/teams
+func (s *TeamsService) ListAllChildTeams(ctx context.Context, org, slug string) ([]*Team, *Response, error) {
+	var (
+		all  []*Team
+		resp *Response
+	)
+	seen := map[string]bool{slug: true}
+	queue := []string{slug}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		opts := &ListOptions{PerPage: 100}
+		for {
+			teams, r, err := s.ListChildTeamsByParentSlug(ctx, org, parent, opts)
+			if err != nil {
+				return nil, r, err
+			}
+			resp = r
+
+			for _, team := range teams {
+				if seen[team.GetSlug()] {
+					continue
+				}
+				seen[team.GetSlug()] = true
+				all = append(all, team)
+				queue = append(queue, team.GetSlug())
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+
+	return all, resp, nil
+}
+
 // ListTeamReposByID lists the repositories given a team ID that the specified team has access to.
 //
 // Deprecated: Use ListTeamReposBySlug instead.
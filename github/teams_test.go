@@ -531,6 +531,51 @@ func TestTeamsService_ListChildTeamsByParentSlug(t *testing.T) {
 	})
 }
 
+func TestTeamsService_ListAllChildTeams(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	// root -> a, b; a -> c; c -> a (cycle).
+	mux.HandleFunc("/orgs/o/teams/root/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "100"})
+		fmt.Fprint(w, `[{"id":2,"slug":"a","parent":{"id":1,"slug":"root"}},{"id":3,"slug":"b","parent":{"id":1,"slug":"root"}}]`)
+	})
+	mux.HandleFunc("/orgs/o/teams/a/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":4,"slug":"c","parent":{"id":2,"slug":"a"}}]`)
+	})
+	mux.HandleFunc("/orgs/o/teams/b/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/orgs/o/teams/c/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":2,"slug":"a","parent":{"id":4,"slug":"c"}}]`)
+	})
+
+	ctx := context.Background()
+	teams, _, err := client.Teams.ListAllChildTeams(ctx, "o", "root")
+	if err != nil {
+		t.Errorf("Teams.ListAllChildTeams returned error: %v", err)
+	}
+
+	want := []*Team{
+		{ID: Ptr(int64(2)), Slug: Ptr("a"), Parent: &Team{ID: Ptr(int64(1)), Slug: Ptr("root")}},
+		{ID: Ptr(int64(3)), Slug: Ptr("b"), Parent: &Team{ID: Ptr(int64(1)), Slug: Ptr("root")}},
+		{ID: Ptr(int64(4)), Slug: Ptr("c"), Parent: &Team{ID: Ptr(int64(2)), Slug: Ptr("a")}},
+	}
+	if !cmp.Equal(teams, want) {
+		t.Errorf("Teams.ListAllChildTeams returned %+v, want %+v", teams, want)
+	}
+
+	const methodName = "ListAllChildTeams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Teams.ListAllChildTeams(ctx, "\n", "\n")
+		return err
+	})
+}
+
 func TestTeamsService_ListTeamReposByID(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
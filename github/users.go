@@ -160,10 +160,10 @@ func (s *UsersService) Edit(ctx context.Context, user *User) (*User, *Response,
 type HovercardOptions struct {
 	// SubjectType specifies the additional information to be received about the hovercard.
 	// Possible values are: organization, repository, issue, pull_request. (Required when using subject_id.)
-	SubjectType string `url:"subject_type"`
+	SubjectType string `url:"subject_type,omitempty"`
 
 	// SubjectID specifies the ID for the SubjectType. (Required when using subject_type.)
-	SubjectID string `url:"subject_id"`
+	SubjectID string `url:"subject_id,omitempty"`
 }
 
 // Hovercard represents hovercard information about a user.
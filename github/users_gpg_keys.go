@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // GPGKey represents a GitHub user's public GPG key used to verify GPG signed commits and tags.
@@ -121,6 +122,41 @@ func (s *UsersService) CreateGPGKey(ctx context.Context, armoredPublicKey string
 	return key, resp, nil
 }
 
+// AddGPGKeyIfMissing adds a GPG key for the authenticated user, unless the
+// user already has it. If GitHub rejects the key as a duplicate, the user's
+// GPG keys are listed and the one with the same armored public key is
+// returned. Otherwise the original error is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/users/gpg-keys#create-a-gpg-key-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/users/gpg-keys#list-gpg-keys-for-the-authenticated-user
+//
+//meta:operation GET /user/gpg_keys
+//meta:operation POST /user/gpg_keys
+func (s *UsersService) AddGPGKeyIfMissing(ctx context.Context, armoredPublicKey string) (*GPGKey, *Response, error) {
+	key, resp, err := s.CreateGPGKey(ctx, armoredPublicKey)
+	if err == nil || !isKeyInUse(err) {
+		return key, resp, err
+	}
+
+	armoredPublicKey = strings.TrimSpace(armoredPublicKey)
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, listResp, listErr := s.ListGPGKeys(ctx, "", opts)
+		if listErr != nil {
+			return nil, listResp, listErr
+		}
+		for _, k := range keys {
+			if strings.TrimSpace(k.GetRawKey()) == armoredPublicKey {
+				return k, listResp, nil
+			}
+		}
+		if listResp.NextPage == 0 {
+			return nil, resp, err
+		}
+		opts.Page = listResp.NextPage
+	}
+}
+
 // DeleteGPGKey deletes a GPG key. It requires authentication via Basic Auth or
 // via OAuth with at least admin:gpg_key scope.
 //
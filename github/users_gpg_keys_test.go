@@ -165,6 +165,34 @@ mQINBFcEd9kBEACo54TDbGhKlXKWMvJgecEUKPPcv7XdnpKdGb3LRw5MvFwT0V0f
 	})
 }
 
+func TestUsersService_AddGPGKeyIfMissing(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"GpgKey","code":"custom","field":"key_id","message":"key_id already exists"}]}`)
+		case "GET":
+			fmt.Fprint(w, `[{"id":2,"raw_key":"other"},{"id":1,"raw_key":"armored\n"}]`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Users.AddGPGKeyIfMissing(ctx, "armored")
+	if err != nil {
+		t.Errorf("Users.AddGPGKeyIfMissing returned error: %v", err)
+	}
+
+	want := &GPGKey{ID: Ptr(int64(1)), RawKey: Ptr("armored\n")}
+	if !cmp.Equal(key, want) {
+		t.Errorf("Users.AddGPGKeyIfMissing returned %+v, want %+v", key, want)
+	}
+}
+
 func TestUsersService_DeleteGPGKey(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
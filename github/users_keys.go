@@ -7,7 +7,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // Key represents a public SSH key used to authenticate a user or deploy script.
@@ -105,6 +108,57 @@ func (s *UsersService) CreateKey(ctx context.Context, key *Key) (*Key, *Response
 	return k, resp, nil
 }
 
+// AddKeyIfMissing adds a public SSH key for the authenticated user, unless
+// the user already has it. GitHub rejects duplicate keys with a 422 "key is
+// already in use" error; in that case the user's keys are listed and the
+// existing one is returned. If the key is in use by another account or as a
+// deploy key, the original error is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/users/keys#create-a-public-ssh-key-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/users/keys#list-public-ssh-keys-for-the-authenticated-user
+//
+//meta:operation GET /user/keys
+//meta:operation POST /user/keys
+func (s *UsersService) AddKeyIfMissing(ctx context.Context, title, pubkey string) (*Key, *Response, error) {
+	key, resp, err := s.CreateKey(ctx, &Key{Title: Ptr(title), Key: Ptr(pubkey)})
+	if err == nil || !isKeyInUse(err) {
+		return key, resp, err
+	}
+
+	opts := &ListOptions{PerPage: 100}
+	for {
+		keys, listResp, listErr := s.ListKeys(ctx, "", opts)
+		if listErr != nil {
+			return nil, listResp, listErr
+		}
+		for _, k := range keys {
+			if sameSSHKey(k.GetKey(), pubkey) {
+				return k, listResp, nil
+			}
+		}
+		if listResp.NextPage == 0 {
+			return nil, resp, err
+		}
+		opts.Page = listResp.NextPage
+	}
+}
+
+// isKeyInUse reports whether err is the 422 validation error GitHub returns
+// when adding an SSH or GPG key that is already registered.
+func isKeyInUse(err error) bool {
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil ||
+		errorResponse.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, e := range errorResponse.Errors {
+		if strings.Contains(e.Message, "already in use") || strings.Contains(e.Message, "already exists") {
+			return true
+		}
+	}
+	return false
+}
+
 // DeleteKey deletes a public key.
 //
 // GitHub API docs: https://docs.github.com/rest/users/keys#delete-a-public-ssh-key-for-the-authenticated-user
@@ -156,6 +156,55 @@ func TestUsersService_CreateKey(t *testing.T) {
 	})
 }
 
+func TestUsersService_AddKeyIfMissing(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/keys", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			testBody(t, r, `{"key":"ssh-ed25519 AAAA","title":"t"}`+"\n")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"PublicKey","code":"custom","field":"key","message":"key is already in use"}]}`)
+		case "GET":
+			fmt.Fprint(w, `[{"id":1,"key":"ssh-ed25519 AAAA"}]`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Users.AddKeyIfMissing(ctx, "t", "ssh-ed25519 AAAA")
+	if err != nil {
+		t.Errorf("Users.AddKeyIfMissing returned error: %v", err)
+	}
+
+	want := &Key{ID: Ptr(int64(1)), Key: Ptr("ssh-ed25519 AAAA")}
+	if !cmp.Equal(key, want) {
+		t.Errorf("Users.AddKeyIfMissing returned %+v, want %+v", key, want)
+	}
+}
+
+func TestUsersService_AddKeyIfMissing_otherValidationError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/user/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed","errors":[{"resource":"PublicKey","code":"custom","field":"key","message":"key is invalid. You must supply a key in OpenSSH public key format"}]}`)
+	})
+
+	ctx := context.Background()
+	key, _, err := client.Users.AddKeyIfMissing(ctx, "t", "bad")
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Users.AddKeyIfMissing returned error %v, want *ErrorResponse", err)
+	}
+	if key != nil {
+		t.Errorf("Users.AddKeyIfMissing returned %+v, want nil", key)
+	}
+}
+
 func TestUsersService_DeleteKey(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
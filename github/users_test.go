@@ -321,6 +321,30 @@ func TestUsersService_GetHovercard(t *testing.T) {
 	})
 }
 
+func TestUsersService_GetHovercard_emptyOptions(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users/u/hovercard", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.RawQuery != "" {
+			t.Errorf("Users.GetHovercard sent query %q, want none", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"contexts": [{"message":"Member of github", "octicon": "organization"}]}`)
+	})
+
+	ctx := context.Background()
+	hovercard, _, err := client.Users.GetHovercard(ctx, "u", &HovercardOptions{})
+	if err != nil {
+		t.Errorf("Users.GetHovercard returned error: %v", err)
+	}
+
+	want := &Hovercard{Contexts: []*UserContext{{Message: Ptr("Member of github"), Octicon: Ptr("organization")}}}
+	if !cmp.Equal(hovercard, want) {
+		t.Errorf("Users.GetHovercard returned %+v, want %+v", hovercard, want)
+	}
+}
+
 func TestUsersService_ListAll(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -5,4 +5,7 @@ go 1.23.0
 require (
 	github.com/google/go-cmp v0.7.0
 	github.com/google/go-querystring v1.1.0
+	golang.org/x/crypto v0.36.0
 )
+
+require golang.org/x/sys v0.31.0 // indirect